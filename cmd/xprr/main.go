@@ -0,0 +1,233 @@
+// Command xprr is XPRR's CLI entry point: it loads the changed packages in
+// a pull request and runs the reviewer's analysis, security, and comment
+// pipelines against them.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis/preset"
+	"github.com/inderanz/x-pull-request-reviewer/internal/llmreview"
+	"github.com/inderanz/x-pull-request-reviewer/internal/security"
+	"github.com/inderanz/x-pull-request-reviewer/internal/vuln"
+	"github.com/inderanz/x-pull-request-reviewer/pkg/autofix"
+	"github.com/inderanz/x-pull-request-reviewer/pkg/metrics"
+	"github.com/inderanz/x-pull-request-reviewer/pkg/review"
+	"github.com/inderanz/x-pull-request-reviewer/pkg/sarif"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "plugins" {
+		runPlugins(os.Args[2:])
+		return
+	}
+	runScan(os.Args[1:])
+}
+
+// runScan is the default behavior: load the PR's packages and run the
+// analysis, security, and vuln pipelines against them.
+func runScan(args []string) {
+	fs := flag.NewFlagSet("xprr", flag.ExitOnError)
+	var (
+		sarifOut     = fs.String("sarif-out", "", "write SAST findings as SARIF 2.1.0 to this path instead of (or in addition to) posting a PR comment")
+		repoSlug     = fs.String("repo", "", "owner/repo, used to link the summary comment to the code-scanning tab")
+		changedFiles = fs.String("changed-files", "", "comma-separated list of files the PR touched, used to decide whether to run govulncheck")
+		strategyFlag = fs.String("strategy", "hunk-by-hunk", "LLM review strategy: \"whole-file\" or \"hunk-by-hunk\"")
+		configPath   = fs.String("config", ".xprr.yml", "path to the analysis config file")
+		prNumber     = fs.Int("pr", 0, "pull request number to post line-anchored review comments to; requires -repo and $GITHUB_TOKEN, otherwise comments print to stdout")
+	)
+	fs.Parse(args)
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+	}, fs.Args()...)
+	if err != nil {
+		log.Fatalf("xprr: loading packages: %v", err)
+	}
+
+	analysisRegistry := preset.Default()
+	cfg, err := analysis.LoadConfig(*configPath)
+	if err != nil {
+		log.Fatalf("xprr: loading %s: %v", *configPath, err)
+	}
+	enabledAnalyzers := cfg.Enabled(analysisRegistry.Names())
+	strategy := reviewStrategy(*strategyFlag)
+	autofixRegistry := autofix.Default()
+
+	var findings []security.Finding
+	var functionMetrics []metrics.FunctionMetrics
+	var analysisFindings []analysis.Finding
+	var comments []llmreview.Comment
+	for _, pkg := range pkgs {
+		secFindings, err := security.Scan(pkg)
+		if err != nil {
+			log.Fatalf("xprr: security scan of %s: %v", pkg.PkgPath, err)
+		}
+		findings = append(findings, secFindings...)
+
+		fnMetrics, err := metrics.Analyze(pkg)
+		if err != nil {
+			log.Fatalf("xprr: metrics analysis of %s: %v", pkg.PkgPath, err)
+		}
+		functionMetrics = append(functionMetrics, fnMetrics...)
+
+		detFindings, err := analysisRegistry.Run(pkg, enabledAnalyzers)
+		if err != nil {
+			log.Fatalf("xprr: static analysis of %s: %v", pkg.PkgPath, err)
+		}
+		detFindings = cfg.Apply(detFindings)
+		analysisFindings = append(analysisFindings, detFindings...)
+
+		suggestions, err := autofixSuggestions(autofixRegistry, detFindings)
+		if err != nil {
+			log.Fatalf("xprr: generating autofix suggestions for %s: %v", pkg.PkgPath, err)
+		}
+		comments = append(comments, suggestions...)
+
+		if llmModel == nil {
+			continue // no LLM client configured in this build; deterministic findings still feed the summary below
+		}
+		for _, goFile := range pkg.GoFiles {
+			src, err := os.ReadFile(goFile)
+			if err != nil {
+				log.Fatalf("xprr: reading %s: %v", goFile, err)
+			}
+			fileComments, err := strategy.Review(context.Background(), llmModel, goFile, src, detFindings)
+			if err != nil {
+				log.Fatalf("xprr: LLM review of %s: %v", goFile, err)
+			}
+			comments = append(comments, fileComments...)
+		}
+	}
+
+	if err := postOrPrintComments(comments, *repoSlug, *prNumber); err != nil {
+		log.Fatalf("xprr: posting review comments: %v", err)
+	}
+
+	if *sarifOut != "" {
+		f, err := os.Create(*sarifOut)
+		if err != nil {
+			log.Fatalf("xprr: creating %s: %v", *sarifOut, err)
+		}
+		defer f.Close()
+		if err := sarif.FromFindings(findings, "xprr", version).Encode(f); err != nil {
+			log.Fatalf("xprr: writing SARIF to %s: %v", *sarifOut, err)
+		}
+	}
+
+	var vulnSection string
+	if files := splitChangedFiles(*changedFiles); vuln.ShouldScan(files) {
+		vulns, err := vuln.NewScanner(".").Scan(context.Background(), "./...")
+		if err != nil {
+			log.Fatalf("xprr: govulncheck scan: %v", err)
+		}
+		vulnSection = review.RenderVulnSection(vulns)
+	}
+
+	fmt.Println(summaryComment(findings, *repoSlug, *sarifOut))
+	if analysisSection := review.RenderAnalysisSection(analysisFindings); analysisSection != "" {
+		fmt.Println(analysisSection)
+	}
+	if vulnSection != "" {
+		fmt.Println(vulnSection)
+	}
+	if hotspotSection := review.RenderHotspotSection(functionMetrics); hotspotSection != "" {
+		fmt.Println(hotspotSection)
+	}
+}
+
+// llmModel is the LLM client the configured ReviewStrategy calls out to.
+// It's left unset in this build: wire it up to whichever provider client
+// this deployment uses before enabling the hunk-by-hunk or whole-file
+// strategies.
+var llmModel llmreview.Model
+
+func reviewStrategy(name string) llmreview.ReviewStrategy {
+	if name == "whole-file" {
+		return llmreview.WholeFile{}
+	}
+	return llmreview.HunkByHunk{}
+}
+
+// autofixSuggestions runs findings through registry, grouping by file so
+// each file's source is read and split into lines only once, and converts
+// the resulting PatchHunks into llmreview.Comment so they ride the same
+// posting path (GitHub review comment, or stdout) as the LLM's own.
+func autofixSuggestions(registry *autofix.Registry, findings []analysis.Finding) ([]llmreview.Comment, error) {
+	byFile := make(map[string][]analysis.Finding)
+	var files []string
+	for _, f := range findings {
+		if _, ok := byFile[f.File]; !ok {
+			files = append(files, f.File)
+		}
+		byFile[f.File] = append(byFile[f.File], f)
+	}
+
+	var comments []llmreview.Comment
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", file, err)
+		}
+		lines := strings.Split(string(src), "\n")
+		var hunks []autofix.PatchHunk
+		for _, f := range byFile[file] {
+			if hunk, ok := registry.Fix(f, lines); ok {
+				hunks = append(hunks, hunk)
+			}
+		}
+		for _, sc := range review.SuggestionComments(hunks) {
+			comments = append(comments, llmreview.Comment{File: sc.Path, Line: sc.Line, StartLine: sc.StartLine, Body: sc.Body})
+		}
+	}
+	return comments, nil
+}
+
+// postOrPrintComments posts comments to the given pull request via
+// review.GitHubPoster when the environment is configured for it (repoSlug
+// in "owner/repo" form, a non-zero prNumber, and $GITHUB_TOKEN all set);
+// otherwise it falls back to printing each comment to stdout, e.g. for a
+// local run with no PR to post against.
+func postOrPrintComments(comments []llmreview.Comment, repoSlug string, prNumber int) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	owner, repo, ok := strings.Cut(repoSlug, "/")
+	token := os.Getenv("GITHUB_TOKEN")
+	if !ok || prNumber == 0 || token == "" {
+		for _, c := range comments {
+			fmt.Printf("%s:%d: %s\n", c.File, c.Line, c.Body)
+		}
+		return nil
+	}
+	poster := &review.GitHubPoster{Owner: owner, Repo: repo, Token: token}
+	return poster.Post(context.Background(), prNumber, comments)
+}
+
+func splitChangedFiles(flagValue string) []string {
+	if flagValue == "" {
+		return nil
+	}
+	return strings.Split(flagValue, ",")
+}
+
+// version is overridden at release build time via -ldflags.
+var version = "dev"
+
+func summaryComment(findings []security.Finding, repoSlug, sarifOut string) string {
+	if sarifOut == "" || repoSlug == "" {
+		return fmt.Sprintf("XPRR SAST scan found %d issue(s).", len(findings))
+	}
+	return fmt.Sprintf(
+		"XPRR SAST scan found %d issue(s). See the annotated results on the [code scanning tab](https://github.com/%s/security/code-scanning).",
+		len(findings), repoSlug,
+	)
+}
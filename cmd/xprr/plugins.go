@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/yaml.v3"
+
+	xprrplugin "github.com/inderanz/x-pull-request-reviewer/internal/plugin"
+)
+
+// runPlugins implements `xprr plugins run`: load every enabled plugin from
+// an xprr-plugins.yml manifest and run it over the packages named on the
+// command line, for local iteration on a plugin before shipping it.
+func runPlugins(args []string) {
+	fs := flag.NewFlagSet("xprr plugins", flag.ExitOnError)
+	manifestPath := fs.String("manifest", "xprr-plugins.yml", "path to the plugin manifest")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 || fs.Arg(0) != "run" {
+		log.Fatalf("xprr plugins: usage: xprr plugins run [-manifest xprr-plugins.yml] <packages...>")
+	}
+
+	raw, err := os.ReadFile(*manifestPath)
+	if err != nil {
+		log.Fatalf("xprr plugins: reading %s: %v", *manifestPath, err)
+	}
+	var manifest xprrplugin.Manifest
+	if err := yaml.Unmarshal(raw, &manifest); err != nil {
+		log.Fatalf("xprr plugins: parsing %s: %v", *manifestPath, err)
+	}
+
+	ctx := context.Background()
+	wasmLoader := xprrplugin.NewWASMLoader(ctx)
+	defer wasmLoader.Close(ctx)
+
+	var adapters []xprrplugin.Adapter
+	for _, entry := range manifest.EnabledEntries() {
+		p, err := loadPlugin(ctx, wasmLoader, entry)
+		if err != nil {
+			log.Fatalf("xprr plugins: loading %s: %v", entry.Name, err)
+		}
+		adapters = append(adapters, xprrplugin.Adapter{Plugin: p})
+	}
+
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+	}, fs.Args()[1:]...)
+	if err != nil {
+		log.Fatalf("xprr plugins: loading packages: %v", err)
+	}
+
+	for _, pkg := range pkgs {
+		for _, a := range adapters {
+			findings, err := a.Run(pkg)
+			if err != nil {
+				log.Fatalf("xprr plugins: %s: %v", a.Name(), err)
+			}
+			for _, f := range findings {
+				fmt.Printf("%s:%d:%d: [%s] %s\n", f.File, f.Line, f.Column, f.Rule, f.Message)
+			}
+		}
+	}
+}
+
+func loadPlugin(ctx context.Context, wasmLoader *xprrplugin.WASMLoader, entry xprrplugin.ManifestEntry) (xprrplugin.Plugin, error) {
+	if err := xprrplugin.VerifyChecksum(entry.Source, entry.SHA256); err != nil {
+		return nil, err
+	}
+	switch entry.Kind {
+	case "wasm":
+		return wasmLoader.Load(ctx, entry.Name, entry.Source)
+	case "go-plugin":
+		return xprrplugin.OpenLoader{}.Load(entry.Source)
+	default:
+		return nil, fmt.Errorf("unknown plugin kind %q for %s", entry.Kind, entry.Name)
+	}
+}
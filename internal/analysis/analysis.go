@@ -0,0 +1,40 @@
+// Package analysis implements XPRR's built-in static-analysis engine: a
+// configurable battery of analyzers that runs over every changed Go file in
+// a pull request, independent of the LLM-driven commentary. It plays the
+// same role golangci-lint plays for govet/gosec/revive/gocyclo/etc. -
+// aggregating many narrow passes behind one Analyzer interface so findings
+// can be ranked, filtered, and rendered consistently.
+package analysis
+
+import (
+	"golang.org/x/tools/go/packages"
+)
+
+// Severity classifies how urgently a Finding should be surfaced to a
+// reviewer. Ordering matters: reviewers and CI gates compare severities,
+// so new values must be inserted in the right rank rather than appended.
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// Finding is a single deterministic issue located in a changed file.
+type Finding struct {
+	Rule     string
+	File     string
+	Line     int
+	Column   int
+	Severity Severity
+	Message  string
+}
+
+// Analyzer is implemented by every rule XPRR knows how to run. Name must be
+// stable and unique across a Registry: it is the key used in .xprr.yml's
+// enable/disable lists and severity overrides.
+type Analyzer interface {
+	Name() string
+	Run(pkg *packages.Package) ([]Finding, error)
+}
@@ -0,0 +1,119 @@
+package analysis
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config mirrors the `.xprr.yml` analysis section: which analyzers run,
+// per-rule severity overrides, and per-path exclusions, modeled directly on
+// golangci-lint's `issues.exclude-rules`.
+type Config struct {
+	Enable   []string            `yaml:"enable"`
+	Disable  []string            `yaml:"disable"`
+	Severity map[string]Severity `yaml:"severity-overrides"`
+	Exclude  []ExcludeRule       `yaml:"exclude-rules"`
+}
+
+// ExcludeRule suppresses findings from Rule under paths matching Path (a
+// regexp, evaluated the same way golangci-lint matches exclude-rules.path).
+type ExcludeRule struct {
+	Path string `yaml:"path"`
+	Rule string `yaml:"rule"`
+}
+
+// fileConfig mirrors .xprr.yml's top-level shape; analysis is the only
+// section XPRR reads today, but other tools may share the file later.
+type fileConfig struct {
+	Analysis Config `yaml:"analysis"`
+}
+
+// LoadConfig reads and parses the analysis section of the .xprr.yml at
+// path. A missing file is not an error: it resolves to the zero Config,
+// which Enabled and SeverityFor treat as "run everything at its default
+// severity."
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Config{}, nil
+	}
+	if err != nil {
+		return Config{}, fmt.Errorf("analysis: reading %s: %w", path, err)
+	}
+	var fc fileConfig
+	if err := yaml.Unmarshal(data, &fc); err != nil {
+		return Config{}, fmt.Errorf("analysis: parsing %s: %w", path, err)
+	}
+	return fc.Analysis, nil
+}
+
+// Enabled resolves Config into a name -> bool set suitable for
+// Registry.Run. Disable takes precedence over Enable so a rule listed in
+// both is treated as disabled.
+func (c Config) Enabled(all []string) map[string]bool {
+	enabled := make(map[string]bool, len(all))
+	only := len(c.Enable) > 0
+	enableSet := toSet(c.Enable)
+	disableSet := toSet(c.Disable)
+	for _, name := range all {
+		switch {
+		case disableSet[name]:
+			enabled[name] = false
+		case only:
+			enabled[name] = enableSet[name]
+		default:
+			enabled[name] = true
+		}
+	}
+	return enabled
+}
+
+// SeverityFor returns the configured override for rule, or def if none was
+// set.
+func (c Config) SeverityFor(rule string, def Severity) Severity {
+	if s, ok := c.Severity[rule]; ok {
+		return s
+	}
+	return def
+}
+
+// Apply resolves severity-overrides and exclude-rules against findings,
+// returning the set a reviewer should actually see. It does not touch
+// enable/disable - that's handled earlier, by passing Enabled's result
+// into Registry.Run so excluded analyzers never execute at all.
+func (c Config) Apply(findings []Finding) []Finding {
+	out := make([]Finding, 0, len(findings))
+	for _, f := range findings {
+		if c.excluded(f) {
+			continue
+		}
+		f.Severity = c.SeverityFor(f.Rule, f.Severity)
+		out = append(out, f)
+	}
+	return out
+}
+
+// excluded reports whether an exclude-rules entry suppresses f: same rule
+// name, and f.File matches the entry's Path regexp.
+func (c Config) excluded(f Finding) bool {
+	for _, ex := range c.Exclude {
+		if ex.Rule != f.Rule {
+			continue
+		}
+		if matched, _ := regexp.MatchString(ex.Path, f.File); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func toSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[n] = true
+	}
+	return set
+}
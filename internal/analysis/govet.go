@@ -0,0 +1,91 @@
+package analysis
+
+import (
+	"fmt"
+
+	goanalysis "golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// PassAnalyzer adapts a golang.org/x/tools/go/analysis.Analyzer - the
+// vocabulary govet's printf, shadow, unusedresult, nilness, etc. passes are
+// all written against - into XPRR's Analyzer interface, translating
+// reported diagnostics into Findings.
+type PassAnalyzer struct {
+	underlying *goanalysis.Analyzer
+	severity   Severity
+}
+
+// WrapPass wraps a go/analysis.Analyzer so it can be registered like any
+// other XPRR rule. Diagnostics are reported at sev unless a .xprr.yml
+// severity override applies.
+func WrapPass(a *goanalysis.Analyzer, sev Severity) *PassAnalyzer {
+	return &PassAnalyzer{underlying: a, severity: sev}
+}
+
+// Name returns the wrapped analyzer's name, e.g. "printf" or "shadow".
+func (p *PassAnalyzer) Name() string { return p.underlying.Name }
+
+// Run executes the wrapped analyzer against pkg, first running everything
+// it Requires (most passes, including printf/shadow/unusedresult, depend
+// on inspect.Analyzer's *inspector.Inspector), and collects its
+// diagnostics as Findings.
+func (p *PassAnalyzer) Run(pkg *packages.Package) ([]Finding, error) {
+	var findings []Finding
+	report := func(d goanalysis.Diagnostic) {
+		pos := pkg.Fset.Position(d.Pos)
+		findings = append(findings, Finding{
+			Rule:     p.underlying.Name,
+			File:     pos.Filename,
+			Line:     pos.Line,
+			Column:   pos.Column,
+			Severity: p.severity,
+			Message:  d.Message,
+		})
+	}
+	memo := make(map[*goanalysis.Analyzer]interface{})
+	if _, err := runWithRequires(p.underlying, pkg, memo, report); err != nil {
+		return nil, err
+	}
+	return findings, nil
+}
+
+// runWithRequires runs a against pkg, recursively running every analyzer it
+// Requires first and wiring their results into ResultOf - the same
+// dependency resolution a full driver like golang.org/x/tools/go/analysis/unitchecker
+// performs, scaled down to the single-package case XPRR needs. memo caches
+// results so a Requires graph shared by several top-level analyzers (e.g.
+// inspect.Analyzer) only runs once per pkg.
+func runWithRequires(
+	a *goanalysis.Analyzer,
+	pkg *packages.Package,
+	memo map[*goanalysis.Analyzer]interface{},
+	report func(goanalysis.Diagnostic),
+) (interface{}, error) {
+	if result, ok := memo[a]; ok {
+		return result, nil
+	}
+	resultOf := make(map[*goanalysis.Analyzer]interface{}, len(a.Requires))
+	for _, req := range a.Requires {
+		result, err := runWithRequires(req, pkg, memo, report)
+		if err != nil {
+			return nil, err
+		}
+		resultOf[req] = result
+	}
+	pass := &goanalysis.Pass{
+		Analyzer:  a,
+		Fset:      pkg.Fset,
+		Files:     pkg.Syntax,
+		Pkg:       pkg.Types,
+		TypesInfo: pkg.TypesInfo,
+		ResultOf:  resultOf,
+		Report:    report,
+	}
+	result, err := a.Run(pass)
+	if err != nil {
+		return nil, fmt.Errorf("analysis: running %s: %w", a.Name, err)
+	}
+	memo[a] = result
+	return result, nil
+}
@@ -0,0 +1,31 @@
+// Package preset wires the built-in rule set into an analysis.Registry.
+// It lives outside package analysis so it can depend on package rules,
+// which itself depends on analysis - keeping the core Analyzer/Registry
+// types free of a cycle back to any specific rule set.
+package preset
+
+import (
+	"golang.org/x/tools/go/analysis/passes/printf"
+	"golang.org/x/tools/go/analysis/passes/shadow"
+	"golang.org/x/tools/go/analysis/passes/unusedresult"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis/rules"
+)
+
+// Default returns the Registry XPRR ships out of the box: the govet passes
+// most teams already run, plus the hand-written rules in package rules that
+// catch the gosec-style anti-patterns govet doesn't.
+func Default() *analysis.Registry {
+	r := analysis.NewRegistry()
+	r.Register(analysis.WrapPass(printf.Analyzer, analysis.SeverityWarning))
+	r.Register(analysis.WrapPass(shadow.Analyzer, analysis.SeverityInfo))
+	r.Register(analysis.WrapPass(unusedresult.Analyzer, analysis.SeverityWarning))
+	r.Register(rules.HardcodedCredential{})
+	r.Register(rules.UncheckedError{})
+	r.Register(rules.LongLine{})
+	r.Register(rules.MagicNumber{})
+	r.Register(rules.UnusedLocal{})
+	r.Register(rules.MissingDocComment{})
+	return r
+}
@@ -0,0 +1,55 @@
+package analysis
+
+import (
+	"fmt"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Registry holds the set of analyzers XPRR will run, in registration order.
+// Order is preserved so findings come back deterministically regardless of
+// map iteration, which matters for golden-file tests and stable comment
+// diffs across runs.
+type Registry struct {
+	analyzers map[string]Analyzer
+	order     []string
+}
+
+// NewRegistry returns an empty Registry ready for Register calls.
+func NewRegistry() *Registry {
+	return &Registry{analyzers: make(map[string]Analyzer)}
+}
+
+// Register adds a to the registry, replacing any prior analyzer with the
+// same Name.
+func (r *Registry) Register(a Analyzer) {
+	name := a.Name()
+	if _, ok := r.analyzers[name]; !ok {
+		r.order = append(r.order, name)
+	}
+	r.analyzers[name] = a
+}
+
+// Names returns the registered analyzer names in registration order.
+func (r *Registry) Names() []string {
+	out := make([]string, len(r.order))
+	copy(out, r.order)
+	return out
+}
+
+// Run executes every enabled analyzer against pkg and returns the combined
+// findings. A nil enabled map runs everything that's registered.
+func (r *Registry) Run(pkg *packages.Package, enabled map[string]bool) ([]Finding, error) {
+	var findings []Finding
+	for _, name := range r.order {
+		if enabled != nil && !enabled[name] {
+			continue
+		}
+		fs, err := r.analyzers[name].Run(pkg)
+		if err != nil {
+			return nil, fmt.Errorf("analyzer %q: %w", name, err)
+		}
+		findings = append(findings, fs...)
+	}
+	return findings, nil
+}
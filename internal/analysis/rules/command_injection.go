@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+var execConstructors = map[string]bool{"Command": true, "CommandContext": true}
+
+// CommandInjection flags calls to os/exec's process constructors. Their
+// arguments frequently originate from request input or another untrusted
+// source, which is exactly the shape of CWE-78 (OS command injection); XPRR
+// cannot prove taint statically, so it flags every call site for human
+// review rather than trying to rule innocent ones out.
+type CommandInjection struct{}
+
+func (CommandInjection) Name() string { return "command-injection" }
+
+func (CommandInjection) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	var findings []analysis.Finding
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkgIdent, ok := sel.X.(*ast.Ident)
+			if !ok || pkgIdent.Name != "exec" || !execConstructors[sel.Sel.Name] {
+				return true
+			}
+			pos := pkg.Fset.Position(call.Pos())
+			findings = append(findings, analysis.Finding{
+				Rule:     "command-injection",
+				File:     pos.Filename,
+				Line:     pos.Line,
+				Column:   pos.Column,
+				Severity: analysis.SeverityWarning,
+				Message:  "exec." + sel.Sel.Name + " executes an external process; verify its arguments can't be influenced by untrusted input",
+			})
+			return true
+		})
+	}
+	return findings, nil
+}
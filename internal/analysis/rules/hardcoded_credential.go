@@ -0,0 +1,56 @@
+// Package rules holds XPRR's hand-written analyzers for the anti-patterns
+// that aren't already covered by a stock go/analysis pass - the kind of
+// thing gosec flags: hardcoded credentials, unchecked destructive calls,
+// overlong lines, and magic numbers.
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+var credentialIdentPattern = regexp.MustCompile(`(?i)(password|passwd|secret|token|apikey|api_key)`)
+
+// HardcodedCredential flags string-literal assignments to identifiers whose
+// name looks like a credential, e.g. `password := "secret123"` (CWE-798).
+type HardcodedCredential struct{}
+
+func (HardcodedCredential) Name() string { return "hardcoded-credential" }
+
+func (HardcodedCredential) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	var findings []analysis.Finding
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok {
+				return true
+			}
+			for i, lhs := range assign.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if !ok || i >= len(assign.Rhs) || !credentialIdentPattern.MatchString(ident.Name) {
+					continue
+				}
+				lit, ok := assign.Rhs[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				pos := pkg.Fset.Position(lit.Pos())
+				findings = append(findings, analysis.Finding{
+					Rule:     "hardcoded-credential",
+					File:     pos.Filename,
+					Line:     pos.Line,
+					Column:   pos.Column,
+					Severity: analysis.SeverityError,
+					Message:  "possible hardcoded credential assigned to " + strconv.Quote(ident.Name),
+				})
+			}
+			return true
+		})
+	}
+	return findings, nil
+}
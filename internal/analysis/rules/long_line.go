@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// MaxLineLength is the default line-length limit, matching lll's default.
+const MaxLineLength = 120
+
+// LongLine flags source lines longer than MaxLineLength characters.
+type LongLine struct {
+	// Limit overrides MaxLineLength when non-zero.
+	Limit int
+}
+
+func (LongLine) Name() string { return "long-line" }
+
+func (r LongLine) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	limit := r.Limit
+	if limit == 0 {
+		limit = MaxLineLength
+	}
+	var findings []analysis.Finding
+	for _, goFile := range pkg.GoFiles {
+		f, err := os.Open(goFile)
+		if err != nil {
+			return nil, err
+		}
+		lineNo := 0
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, 1024), 1024*1024)
+		for scanner.Scan() {
+			lineNo++
+			if len(scanner.Text()) > limit {
+				findings = append(findings, analysis.Finding{
+					Rule:     "long-line",
+					File:     goFile,
+					Line:     lineNo,
+					Severity: analysis.SeverityInfo,
+					Message:  "line exceeds maximum length",
+				})
+			}
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// allowedMagicNumbers are values common enough to carry no extra meaning on
+// their own (indices, bit shifts, the empty/singular cases).
+var allowedMagicNumbers = map[string]bool{"0": true, "1": true, "2": true}
+
+// MagicNumber flags integer literals outside const declarations that aren't
+// in allowedMagicNumbers, e.g. `timeout := 30` with no named constant.
+type MagicNumber struct{}
+
+func (MagicNumber) Name() string { return "magic-number" }
+
+func (MagicNumber) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	var findings []analysis.Finding
+	for _, file := range pkg.Syntax {
+		// constStack mirrors ast.Inspect's traversal one frame per visited
+		// node (popped on its post-order f(nil) call) so we know, at any
+		// BasicLit, whether an enclosing GenDecl is a `const (...)` block.
+		var constStack []bool
+		ast.Inspect(file, func(n ast.Node) bool {
+			if n == nil {
+				constStack = constStack[:len(constStack)-1]
+				return true
+			}
+			inConst := len(constStack) > 0 && constStack[len(constStack)-1]
+			if decl, ok := n.(*ast.GenDecl); ok && decl.Tok == token.CONST {
+				inConst = true
+			}
+			constStack = append(constStack, inConst)
+
+			lit, ok := n.(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT || inConst || allowedMagicNumbers[lit.Value] {
+				return true
+			}
+			pos := pkg.Fset.Position(lit.Pos())
+			findings = append(findings, analysis.Finding{
+				Rule:     "magic-number",
+				File:     pos.Filename,
+				Line:     pos.Line,
+				Column:   pos.Column,
+				Severity: analysis.SeverityInfo,
+				Message:  "magic number " + lit.Value + " should be extracted into a named constant",
+			})
+			return true
+		})
+	}
+	return findings, nil
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// MissingDocComment flags the package clause and every exported top-level
+// func/type declaration that has no doc comment, mirroring golint's stdlib
+// doc-comment convention.
+type MissingDocComment struct{}
+
+func (MissingDocComment) Name() string { return "missing-doc-comment" }
+
+func (MissingDocComment) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	var findings []analysis.Finding
+	for _, file := range pkg.Syntax {
+		if file.Doc == nil {
+			pos := pkg.Fset.Position(file.Package)
+			findings = append(findings, analysis.Finding{
+				Rule:     "missing-doc-comment",
+				File:     pos.Filename,
+				Line:     pos.Line,
+				Column:   pos.Column,
+				Severity: analysis.SeverityInfo,
+				Message:  "package " + file.Name.Name + " is missing a package comment",
+			})
+		}
+		for _, decl := range file.Decls {
+			name, doc, pos := declDoc(decl)
+			if name == "" || !ast.IsExported(name) || doc != nil {
+				continue
+			}
+			position := pkg.Fset.Position(pos)
+			findings = append(findings, analysis.Finding{
+				Rule:     "missing-doc-comment",
+				File:     position.Filename,
+				Line:     position.Line,
+				Column:   position.Column,
+				Severity: analysis.SeverityInfo,
+				Message:  "exported " + name + " is missing a doc comment starting with \"" + name + " \"",
+			})
+		}
+	}
+	return findings, nil
+}
+
+// declDoc extracts the exported name, doc comment, and position to report
+// for the declarations MissingDocComment cares about (funcs and top-level
+// type specs); other declarations return an empty name.
+func declDoc(decl ast.Decl) (name string, doc *ast.CommentGroup, pos token.Pos) {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv != nil {
+			return "", nil, token.NoPos // method docs are optional in this pass
+		}
+		return d.Name.Name, d.Doc, d.Pos()
+	case *ast.GenDecl:
+		if len(d.Specs) != 1 {
+			return "", nil, token.NoPos // multi-spec blocks document the group, not each spec
+		}
+		if ts, ok := d.Specs[0].(*ast.TypeSpec); ok {
+			doc := d.Doc
+			if doc == nil {
+				doc = ts.Doc
+			}
+			return ts.Name.Name, doc, d.Pos()
+		}
+	}
+	return "", nil, token.NoPos
+}
@@ -0,0 +1,144 @@
+package rules_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis/rules"
+)
+
+func TestMagicNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		wantLines []int
+	}{
+		{
+			name: "unnamed literal is flagged",
+			src: `package main
+
+func f() int {
+	timeout := 30
+	return timeout
+}
+`,
+			wantLines: []int{4},
+		},
+		{
+			name: "allowed literals are not flagged",
+			src: `package main
+
+func f() int {
+	return 0 + 1 + 2
+}
+`,
+			wantLines: nil,
+		},
+		{
+			name: "literal inside a const block is not flagged",
+			src: `package main
+
+const Timeout = 30
+`,
+			wantLines: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := loadPackage(t, tt.src)
+			findings, err := rules.MagicNumber{}.Run(pkg)
+			if err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+			var gotLines []int
+			for _, f := range findings {
+				gotLines = append(gotLines, f.Line)
+			}
+			if !equalInts(gotLines, tt.wantLines) {
+				t.Errorf("got lines %v, want %v", gotLines, tt.wantLines)
+			}
+		})
+	}
+}
+
+func TestLongLine(t *testing.T) {
+	short := `package main
+
+func f() {}
+`
+	long := "package main\n\nfunc f() {\n\t_ = \"" + strings.Repeat("x", 200) + "\"\n}\n"
+
+	tests := []struct {
+		name      string
+		src       string
+		wantLines []int
+	}{
+		{name: "short file has no findings", src: short, wantLines: nil},
+		{name: "overlong line is flagged", src: long, wantLines: []int{4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := loadPackage(t, tt.src)
+			findings, err := rules.LongLine{}.Run(pkg)
+			if err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+			var gotLines []int
+			for _, f := range findings {
+				gotLines = append(gotLines, f.Line)
+			}
+			if !equalInts(gotLines, tt.wantLines) {
+				t.Errorf("got lines %v, want %v", gotLines, tt.wantLines)
+			}
+		})
+	}
+}
+
+func TestUncheckedError(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		wantLines []int
+	}{
+		{
+			name: "dropped destructive call error is flagged",
+			src: `package main
+
+import "os"
+
+func f() {
+	os.Remove("temp.txt")
+}
+`,
+			wantLines: []int{6},
+		},
+		{
+			name: "checked error is not flagged",
+			src: `package main
+
+import "os"
+
+func f() error {
+	return os.Remove("temp.txt")
+}
+`,
+			wantLines: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := loadPackage(t, tt.src)
+			findings, err := rules.UncheckedError{}.Run(pkg)
+			if err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+			var gotLines []int
+			for _, f := range findings {
+				gotLines = append(gotLines, f.Line)
+			}
+			if !equalInts(gotLines, tt.wantLines) {
+				t.Errorf("got lines %v, want %v", gotLines, tt.wantLines)
+			}
+		})
+	}
+}
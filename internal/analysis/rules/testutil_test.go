@@ -0,0 +1,35 @@
+package rules_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadPackage writes src as the sole file of a throwaway module and loads
+// it with full type information, the same Mode an Analyzer.Run gets from
+// cmd/xprr. It's shared by every rule's table-driven tests so each one
+// only has to supply the snippet it cares about.
+func loadPackage(t *testing.T, src string) *packages.Package {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.test\n\ngo 1.21\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkgs, err := packages.Load(&packages.Config{
+		Dir:  dir,
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+	}, ".")
+	if err != nil {
+		t.Fatalf("loading test package: %v", err)
+	}
+	if len(pkgs) != 1 {
+		t.Fatalf("expected exactly one package, got %d", len(pkgs))
+	}
+	return pkgs[0]
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// destructiveCalls are functions whose error return must never be silently
+// dropped because ignoring it hides a failed side effect.
+var destructiveCalls = map[string]map[string]bool{
+	"os": {"Remove": true, "RemoveAll": true, "Truncate": true},
+}
+
+// UncheckedError flags calls to destructiveCalls made as a bare expression
+// statement, so the returned error is discarded (CWE-252), e.g.
+// `os.Remove("temp.txt")` with no error check.
+type UncheckedError struct{}
+
+func (UncheckedError) Name() string { return "unchecked-error" }
+
+func (UncheckedError) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	var findings []analysis.Finding
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(n ast.Node) bool {
+			exprStmt, ok := n.(*ast.ExprStmt)
+			if !ok {
+				return true
+			}
+			call, ok := exprStmt.X.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkgIdent, ok := sel.X.(*ast.Ident)
+			if !ok || !destructiveCalls[pkgIdent.Name][sel.Sel.Name] {
+				return true
+			}
+			pos := pkg.Fset.Position(call.Pos())
+			findings = append(findings, analysis.Finding{
+				Rule:     "unchecked-error",
+				File:     pos.Filename,
+				Line:     pos.Line,
+				Column:   pos.Column,
+				Severity: analysis.SeverityWarning,
+				Message:  "error result of " + pkgIdent.Name + "." + sel.Sel.Name + " is not checked",
+			})
+			return true
+		})
+	}
+	return findings, nil
+}
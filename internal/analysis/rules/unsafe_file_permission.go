@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// permArgIndex gives the zero-based argument position of the os.FileMode
+// permission bits for each constructor we check.
+var permArgIndex = map[string]int{"OpenFile": 2, "Chmod": 1, "Mkdir": 1, "MkdirAll": 1}
+
+// UnsafeFilePermission flags os.* calls that create or modify files with
+// world-writable permission bits (CWE-276).
+type UnsafeFilePermission struct{}
+
+func (UnsafeFilePermission) Name() string { return "unsafe-file-permission" }
+
+func (UnsafeFilePermission) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	var findings []analysis.Finding
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkgIdent, ok := sel.X.(*ast.Ident)
+			if !ok || pkgIdent.Name != "os" {
+				return true
+			}
+			idx, ok := permArgIndex[sel.Sel.Name]
+			if !ok || idx >= len(call.Args) {
+				return true
+			}
+			lit, ok := call.Args[idx].(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				return true
+			}
+			perm, err := strconv.ParseInt(lit.Value, 0, 64)
+			if err != nil || perm&0o002 == 0 {
+				return true
+			}
+			pos := pkg.Fset.Position(lit.Pos())
+			findings = append(findings, analysis.Finding{
+				Rule:     "unsafe-file-permission",
+				File:     pos.Filename,
+				Line:     pos.Line,
+				Column:   pos.Column,
+				Severity: analysis.SeverityError,
+				Message:  "os." + sel.Sel.Name + " grants world-writable permissions (" + lit.Value + ")",
+			})
+			return true
+		})
+	}
+	return findings, nil
+}
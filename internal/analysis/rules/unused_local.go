@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// UnusedLocal flags local variables that are declared (via `:=` or `var`)
+// but never read, e.g. `unusedVar := "this is unused"`. It relies on
+// TypesInfo rather than a name-matching heuristic, so shadowing and
+// multi-assignment are handled the same way the compiler sees them.
+//
+// Only genuine function-local declarations are considered: function
+// parameters and package-level `var`s share the same ast.Object.Decl shapes
+// (*ast.Field and *ast.ValueSpec respectively) as local declarations, but
+// neither is a local variable, and both are legal to leave unread.
+type UnusedLocal struct{}
+
+func (UnusedLocal) Name() string { return "unused-local" }
+
+func (UnusedLocal) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	if pkg.TypesInfo == nil {
+		return nil, nil
+	}
+	used := make(map[*ast.Object]bool)
+	for id := range pkg.TypesInfo.Uses {
+		used[id.Obj] = true
+	}
+
+	var findings []analysis.Finding
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			findings = append(findings, unusedInBody(pkg, fn.Body, used)...)
+		}
+	}
+	return findings, nil
+}
+
+// unusedInBody reports every `:=`/`var` declaration local to body (including
+// nested func literals) whose variable TypesInfo never recorded a Uses
+// entry for.
+func unusedInBody(pkg *packages.Package, body *ast.BlockStmt, used map[*ast.Object]bool) []analysis.Finding {
+	// localDecls holds the declaring nodes (AssignStmt for `:=`, GenDecl for
+	// `var`) that occur inside this body, as opposed to a function's
+	// parameter list or a package-level var block - both of which an
+	// ast.Object can also point to, but neither of which is a local.
+	localDecls := make(map[ast.Node]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch d := n.(type) {
+		case *ast.AssignStmt:
+			if d.Tok == token.DEFINE {
+				localDecls[d] = true
+			}
+		case *ast.GenDecl:
+			if d.Tok == token.VAR {
+				localDecls[d] = true
+			}
+		}
+		return true
+	})
+
+	var findings []analysis.Finding
+	ast.Inspect(body, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok || ident.Name == "_" || ident.Obj == nil || ident.Obj.Kind != ast.Var {
+			return true
+		}
+		// Only the declaring identifier carries ast.Object.Decl; skip
+		// later references to the same variable, and anything whose
+		// declaration isn't one of this body's own AssignStmt/GenDecl
+		// nodes (function parameters, package-level vars).
+		declNode, ok := ident.Obj.Decl.(ast.Node)
+		if !ok || declNode.Pos() != ident.Pos() || !localDecls[declNode] {
+			return true
+		}
+		if used[ident.Obj] {
+			return true
+		}
+		pos := pkg.Fset.Position(ident.Pos())
+		findings = append(findings, analysis.Finding{
+			Rule:     "unused-local",
+			File:     pos.Filename,
+			Line:     pos.Line,
+			Column:   pos.Column,
+			Severity: analysis.SeverityWarning,
+			Message:  "local variable " + ident.Name + " is declared but never used",
+		})
+		return true
+	})
+	return findings
+}
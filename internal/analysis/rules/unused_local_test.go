@@ -0,0 +1,99 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis/rules"
+)
+
+func TestUnusedLocal(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		wantLines []int
+	}{
+		{
+			name: "unused local is flagged",
+			src: `package main
+
+func f() {
+	unused := 1
+	_ = unused
+}
+`,
+			wantLines: nil, // unused is read via _ = unused, so it's used
+		},
+		{
+			name: "genuinely unused local is flagged",
+			src: `package main
+
+func f() {
+	unused := 1
+}
+`,
+			wantLines: []int{4},
+		},
+		{
+			name: "unused function parameter is not flagged",
+			src: `package main
+
+func f(unused int) {
+}
+`,
+			wantLines: nil,
+		},
+		{
+			name: "unused package-level var is not flagged",
+			src: `package main
+
+var unused int
+
+func f() {}
+`,
+			wantLines: nil,
+		},
+		{
+			name: "only the unused name in a multi-assign is flagged",
+			src: `package main
+
+import "fmt"
+
+func f() (int, error) {
+	a, b := 1, 2
+	fmt.Println(a)
+	return b, nil
+}
+`,
+			wantLines: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := loadPackage(t, tt.src)
+			findings, err := rules.UnusedLocal{}.Run(pkg)
+			if err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+			var gotLines []int
+			for _, f := range findings {
+				gotLines = append(gotLines, f.Line)
+			}
+			if !equalInts(gotLines, tt.wantLines) {
+				t.Errorf("got lines %v, want %v (findings: %+v)", gotLines, tt.wantLines, findings)
+			}
+		})
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
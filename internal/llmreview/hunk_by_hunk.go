@@ -0,0 +1,76 @@
+package llmreview
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+)
+
+// HunkByHunk slices a file into one Hunk per top-level function
+// declaration, attaches the analyzer findings that fall inside each hunk,
+// and reviews them independently so each model reply can be posted as its
+// own line-anchored review comment. This keeps token usage proportional to
+// the size of the PR instead of the size of the file, and lets a
+// findings-derived citation land on the line it's actually about.
+type HunkByHunk struct{}
+
+// Review implements ReviewStrategy.
+func (HunkByHunk) Review(ctx context.Context, model Model, file string, source []byte, findings []analysis.Finding) ([]Comment, error) {
+	hunks, err := splitFunctions(file, source, findings)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]Comment, 0, len(hunks))
+	for _, h := range hunks {
+		reply, err := model.Complete(ctx, buildPrompt(h.File, h.Source, h.Findings))
+		if err != nil {
+			return nil, fmt.Errorf("llmreview: reviewing %s:%d-%d: %w", h.File, h.StartLine, h.EndLine, err)
+		}
+		comments = append(comments, Comment{File: h.File, Line: h.EndLine, StartLine: h.StartLine, Body: reply})
+	}
+	return comments, nil
+}
+
+// splitFunctions parses source and returns one Hunk per top-level
+// function, each carrying the findings whose line falls within its range.
+func splitFunctions(file string, source []byte, findings []analysis.Finding) ([]Hunk, error) {
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, file, source, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("llmreview: parsing %s: %w", file, err)
+	}
+	lines := bytes.Split(source, []byte("\n"))
+
+	var hunks []Hunk
+	for _, decl := range astFile.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		start := fset.Position(fn.Pos()).Line
+		end := fset.Position(fn.End()).Line
+		hunks = append(hunks, Hunk{
+			File:      file,
+			StartLine: start,
+			EndLine:   end,
+			Source:    string(bytes.Join(lines[start-1:end], []byte("\n"))),
+			Findings:  findingsInRange(findings, start, end),
+		})
+	}
+	return hunks, nil
+}
+
+func findingsInRange(findings []analysis.Finding, start, end int) []analysis.Finding {
+	var in []analysis.Finding
+	for _, f := range findings {
+		if f.Line >= start && f.Line <= end {
+			in = append(in, f)
+		}
+	}
+	return in
+}
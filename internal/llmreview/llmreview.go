@@ -0,0 +1,45 @@
+// Package llmreview implements XPRR's LLM-driven commentary pass: the part
+// of a review that goes beyond what the deterministic analyzers in
+// internal/analysis can prove and reads the diff the way a human reviewer
+// would. A ReviewStrategy decides how a changed file is sliced before it
+// reaches the model and how the model's replies are anchored back onto the
+// diff.
+package llmreview
+
+import (
+	"context"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+)
+
+// Hunk is a reviewable slice of a changed file: WholeFile produces one per
+// file, HunkByHunk one per top-level function.
+type Hunk struct {
+	File      string
+	StartLine int
+	EndLine   int
+	Source    string
+	Findings  []analysis.Finding // deterministic findings whose Line falls inside this hunk
+}
+
+// Comment is the model's reply for one Hunk, ready to post as a
+// line-anchored GitHub review comment.
+type Comment struct {
+	File      string
+	Line      int
+	StartLine int // zero when the comment spans a single line
+	Body      string
+}
+
+// Model is the subset of an LLM client a ReviewStrategy needs: given a
+// prompt, return its reply.
+type Model interface {
+	Complete(ctx context.Context, prompt string) (string, error)
+}
+
+// ReviewStrategy reviews one changed file's source, informed by the
+// deterministic findings internal/analysis already produced for it, and
+// returns the comments to post.
+type ReviewStrategy interface {
+	Review(ctx context.Context, model Model, file string, source []byte, findings []analysis.Finding) ([]Comment, error)
+}
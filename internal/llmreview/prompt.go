@@ -0,0 +1,28 @@
+package llmreview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+)
+
+// buildPrompt assembles the prompt for one hunk (or a whole file),
+// attaching the deterministic findings that fall inside it as structured
+// context so the model can cite them precisely ("hardcoded `secret123` on
+// line 24") instead of rediscovering the same issues in prose.
+func buildPrompt(file, source string, findings []analysis.Finding) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Review the following Go code from %s.\n\n", file)
+	if len(findings) > 0 {
+		b.WriteString("Deterministic analyzers already found:\n")
+		for _, f := range findings {
+			fmt.Fprintf(&b, "- line %d [%s/%s]: %s\n", f.Line, f.Rule, f.Severity, f.Message)
+		}
+		b.WriteString("\nDon't restate these; focus on what they don't cover.\n\n")
+	}
+	b.WriteString("```go\n")
+	b.WriteString(source)
+	b.WriteString("\n```\n")
+	return b.String()
+}
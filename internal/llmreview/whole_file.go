@@ -0,0 +1,22 @@
+package llmreview
+
+import (
+	"context"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+)
+
+// WholeFile sends an entire changed file to the model in one prompt and
+// posts the reply as a single comment anchored to line 1 - XPRR's original
+// behavior, kept as an opt-in for small files where per-function slicing
+// buys nothing.
+type WholeFile struct{}
+
+// Review implements ReviewStrategy.
+func (WholeFile) Review(ctx context.Context, model Model, file string, source []byte, findings []analysis.Finding) ([]Comment, error) {
+	reply, err := model.Complete(ctx, buildPrompt(file, string(source), findings))
+	if err != nil {
+		return nil, err
+	}
+	return []Comment{{File: file, Line: 1, Body: reply}}, nil
+}
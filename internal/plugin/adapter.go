@@ -0,0 +1,45 @@
+package plugin
+
+import (
+	"os"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+)
+
+// Adapter wraps a loaded Plugin so it can be registered into an
+// analysis.Registry alongside the built-in rules.
+type Adapter struct {
+	Plugin Plugin
+}
+
+// Name returns the plugin's own name, prefixed so it's obviously
+// user-supplied in a findings list next to built-in rules.
+func (a Adapter) Name() string { return "plugin/" + a.Plugin.Name() }
+
+// Run reads every Go file in pkg and asks the plugin to inspect it.
+func (a Adapter) Run(pkg *packages.Package) ([]analysis.Finding, error) {
+	var findings []analysis.Finding
+	for _, goFile := range pkg.GoFiles {
+		src, err := os.ReadFile(goFile)
+		if err != nil {
+			return nil, err
+		}
+		pluginFindings, err := a.Plugin.Inspect(FileInput{Path: goFile, Source: string(src)})
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range pluginFindings {
+			findings = append(findings, analysis.Finding{
+				Rule:     a.Plugin.Name() + "/" + f.Rule,
+				File:     f.File,
+				Line:     f.Line,
+				Column:   f.Column,
+				Severity: analysis.Severity(f.Severity),
+				Message:  f.Message,
+			})
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,39 @@
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// VerifyChecksum hashes the file at path with SHA-256 and compares it
+// against want (hex-encoded, case-insensitive). A plugin binary is
+// everything that runs with the host's privileges (an in-process Go
+// plugin) or inside its sandbox (a WASM module); the manifest's sha256 is
+// the only thing standing between "the source we reviewed" and "whatever
+// is on disk right now", so a missing or mismatched checksum is always an
+// error, never a warning.
+func VerifyChecksum(path, want string) error {
+	want = strings.ToLower(strings.TrimSpace(want))
+	if want == "" {
+		return fmt.Errorf("plugin: %s has no sha256 in the manifest, refusing to load", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("plugin: opening %s for checksum verification: %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("plugin: hashing %s: %w", path, err)
+	}
+	got := hex.EncodeToString(h.Sum(nil))
+	if got != want {
+		return fmt.Errorf("plugin: %s checksum mismatch: manifest has %s, computed %s", path, want, got)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package plugin_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/plugin"
+)
+
+func TestVerifyChecksum(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rule.wasm")
+	content := []byte("not actually wasm, just checksum fodder")
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(content)
+	want := hex.EncodeToString(sum[:])
+
+	if err := plugin.VerifyChecksum(path, "0000000000000000000000000000000000000000000000000000000000000000"); err == nil {
+		t.Fatal("expected a checksum mismatch against a made-up hash")
+	}
+	if err := plugin.VerifyChecksum(path, want); err != nil {
+		t.Errorf("VerifyChecksum with the real checksum: %v", err)
+	}
+	if err := plugin.VerifyChecksum(path, strings.ToUpper(want)); err != nil {
+		t.Errorf("VerifyChecksum with an uppercased checksum: %v", err)
+	}
+	if err := plugin.VerifyChecksum(path, ""); err == nil {
+		t.Error("expected an empty manifest checksum to be refused")
+	}
+	if err := plugin.VerifyChecksum(filepath.Join(dir, "missing.wasm"), want); err == nil {
+		t.Error("expected an error for a nonexistent file")
+	}
+}
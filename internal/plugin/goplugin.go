@@ -0,0 +1,31 @@
+//go:build linux || darwin
+
+package plugin
+
+import (
+	"fmt"
+	goplugin "plugin"
+)
+
+// OpenLoader loads trusted in-process Go plugins built with
+// `go build -buildmode=plugin`. It's only available on the platforms
+// Go's own plugin package supports.
+type OpenLoader struct{}
+
+// Load opens the .so at path and instantiates the Plugin its `New`
+// symbol constructs.
+func (OpenLoader) Load(path string) (Plugin, error) {
+	p, err := goplugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("plugin: opening %s: %w", path, err)
+	}
+	sym, err := p.Lookup("New")
+	if err != nil {
+		return nil, fmt.Errorf("plugin: %s has no New symbol: %w", path, err)
+	}
+	newFunc, ok := sym.(func() Plugin)
+	if !ok {
+		return nil, fmt.Errorf("plugin: %s's New symbol has the wrong signature, want func() plugin.Plugin", path)
+	}
+	return newFunc(), nil
+}
@@ -0,0 +1,29 @@
+package plugin
+
+// Manifest is the parsed form of `xprr-plugins.yml`: the plugin sources a
+// repo wants XPRR to load, mirroring how golangci-lint's custom-linter
+// plugins and revive's external rules are declared.
+type Manifest struct {
+	Plugins []ManifestEntry `yaml:"plugins"`
+}
+
+// ManifestEntry describes a single plugin source.
+type ManifestEntry struct {
+	Name    string `yaml:"name"`
+	Kind    string `yaml:"kind"` // "go-plugin" or "wasm"
+	Source  string `yaml:"source"`
+	SHA256  string `yaml:"sha256"`
+	Enabled bool   `yaml:"enabled"`
+}
+
+// Enabled returns the subset of m.Plugins with Enabled set, in manifest
+// order.
+func (m Manifest) EnabledEntries() []ManifestEntry {
+	var enabled []ManifestEntry
+	for _, e := range m.Plugins {
+		if e.Enabled {
+			enabled = append(enabled, e)
+		}
+	}
+	return enabled
+}
@@ -0,0 +1,33 @@
+// Package plugin lets XPRR users extend the reviewer with their own
+// analyzers without forking the repo. A manifest lists plugin sources, and
+// one of two loaders brings each into an analysis.Registry: OpenLoader for
+// trusted in-process Go plugins, or WASMLoader for sandboxed rule modules.
+package plugin
+
+// FileInput is what XPRR passes to a plugin's Inspect: the source of one
+// changed file. It deliberately avoids go/ast and go/packages types so
+// both Go-plugin and WASM modules can consume it with nothing more than
+// stdlib JSON.
+type FileInput struct {
+	Path   string `json:"path"`
+	Source string `json:"source"`
+}
+
+// Finding is the plugin-facing finding shape; internal/plugin.Adapter
+// translates it into an analysis.Finding once it knows which plugin
+// produced it.
+type Finding struct {
+	Rule     string `json:"rule"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// Plugin is the contract every loaded plugin satisfies, independent of
+// whether it arrived via OpenLoader or WASMLoader.
+type Plugin interface {
+	Name() string
+	Inspect(file FileInput) ([]Finding, error)
+}
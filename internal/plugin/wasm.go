@@ -0,0 +1,104 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// WASMLoader runs sandboxed rule modules compiled to WebAssembly. Each
+// module must export an `inspect` function that reads the file JSON from
+// its own linear memory and returns a (pointer, length) pair pointing at
+// the findings JSON it wrote back - the same calling convention wazero's
+// own examples use for passing strings across the host/guest boundary.
+type WASMLoader struct {
+	runtime wazero.Runtime
+}
+
+// NewWASMLoader starts a wazero runtime that WASMLoader.Load will
+// instantiate plugin modules into.
+func NewWASMLoader(ctx context.Context) *WASMLoader {
+	return &WASMLoader{runtime: wazero.NewRuntime(ctx)}
+}
+
+// Close releases the underlying wazero runtime and every module
+// instantiated from it.
+func (l *WASMLoader) Close(ctx context.Context) error {
+	return l.runtime.Close(ctx)
+}
+
+// Load reads the WASM binary at wasmPath, instantiates it, and returns a
+// Plugin backed by its exported `inspect` function.
+func (l *WASMLoader) Load(ctx context.Context, name, wasmPath string) (Plugin, error) {
+	bin, err := os.ReadFile(wasmPath)
+	if err != nil {
+		return nil, fmt.Errorf("plugin: reading %s: %w", wasmPath, err)
+	}
+	mod, err := l.runtime.Instantiate(ctx, bin)
+	if err != nil {
+		return nil, fmt.Errorf("plugin: instantiating %s: %w", wasmPath, err)
+	}
+	inspect := mod.ExportedFunction("inspect")
+	if inspect == nil {
+		return nil, fmt.Errorf("plugin: %s does not export an inspect function", wasmPath)
+	}
+	return &wasmPlugin{name: name, mod: mod, inspect: inspect}, nil
+}
+
+type wasmPlugin struct {
+	name    string
+	mod     api.Module
+	inspect api.Function
+}
+
+func (p *wasmPlugin) Name() string { return p.name }
+
+// Inspect marshals file to JSON, writes it into the module's memory,
+// invokes inspect, and unmarshals the findings JSON it returns.
+func (p *wasmPlugin) Inspect(file FileInput) ([]Finding, error) {
+	in, err := json.Marshal(file)
+	if err != nil {
+		return nil, err
+	}
+	ptr, size, err := writeToGuestMemory(p.mod, in)
+	if err != nil {
+		return nil, fmt.Errorf("plugin: writing input for %s: %w", p.name, err)
+	}
+	results, err := p.inspect.Call(context.Background(), ptr, size)
+	if err != nil {
+		return nil, fmt.Errorf("plugin: %s.inspect: %w", p.name, err)
+	}
+	outPtr, outLen := api.DecodeU32(results[0]), api.DecodeU32(results[0]>>32)
+	out, ok := p.mod.Memory().Read(outPtr, outLen)
+	if !ok {
+		return nil, fmt.Errorf("plugin: %s returned an out-of-bounds memory region", p.name)
+	}
+	var findings []Finding
+	if err := json.Unmarshal(out, &findings); err != nil {
+		return nil, fmt.Errorf("plugin: decoding %s findings: %w", p.name, err)
+	}
+	return findings, nil
+}
+
+// writeToGuestMemory allocates space in the module's memory (via its
+// exported `alloc`) and copies data into it, returning the pointer/size
+// pair the guest's inspect function expects.
+func writeToGuestMemory(mod api.Module, data []byte) (ptr, size uint64, err error) {
+	alloc := mod.ExportedFunction("alloc")
+	if alloc == nil {
+		return 0, 0, fmt.Errorf("module does not export an alloc function")
+	}
+	results, err := alloc.Call(context.Background(), uint64(len(data)))
+	if err != nil {
+		return 0, 0, err
+	}
+	ptr = results[0]
+	if !mod.Memory().Write(uint32(ptr), data) {
+		return 0, 0, fmt.Errorf("writing %d bytes at offset %d is out of bounds", len(data), ptr)
+	}
+	return ptr, uint64(len(data)), nil
+}
@@ -0,0 +1,55 @@
+// Package security implements XPRR's SAST mode: a curated subset of
+// internal/analysis's rules, restricted to the ones that map onto a known
+// CWE, with each Finding enriched with that CWE id. These rules are
+// generic code-pattern detectors (e.g. "this looks like a hardcoded
+// credential"), not matches against a specific vulnerable dependency
+// version, so there's no CVE to attribute them to - that's what
+// internal/vuln's govulncheck integration is for.
+package security
+
+import (
+	"golang.org/x/tools/go/packages"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis/rules"
+)
+
+// Finding extends analysis.Finding with the metadata a SAST gate or
+// compliance tracker needs.
+type Finding struct {
+	analysis.Finding
+	CWE string
+}
+
+// cwe maps each security-relevant rule name to its CWE id.
+var cwe = map[string]string{
+	"command-injection":      "CWE-78",
+	"hardcoded-credential":   "CWE-798",
+	"unchecked-error":        "CWE-252",
+	"unsafe-file-permission": "CWE-276",
+}
+
+// Registry returns the Registry backing Scan: only the rules with a known
+// CWE mapping, so a SAST report never contains an un-attributable finding.
+func Registry() *analysis.Registry {
+	r := analysis.NewRegistry()
+	r.Register(rules.CommandInjection{})
+	r.Register(rules.HardcodedCredential{})
+	r.Register(rules.UncheckedError{})
+	r.Register(rules.UnsafeFilePermission{})
+	return r
+}
+
+// Scan runs the security Registry against pkg and enriches every resulting
+// Finding with its CWE id.
+func Scan(pkg *packages.Package) ([]Finding, error) {
+	raw, err := Registry().Run(pkg, nil)
+	if err != nil {
+		return nil, err
+	}
+	findings := make([]Finding, len(raw))
+	for i, f := range raw {
+		findings[i] = Finding{Finding: f, CWE: cwe[f.Rule]}
+	}
+	return findings, nil
+}
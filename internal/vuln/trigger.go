@@ -0,0 +1,20 @@
+package vuln
+
+import "strings"
+
+// ShouldScan reports whether a PR touching changedFiles warrants a
+// govulncheck run: it modified go.mod/go.sum (the dependency graph itself)
+// or at least one .go file (which may add a new call into a vulnerable
+// symbol).
+func ShouldScan(changedFiles []string) bool {
+	for _, f := range changedFiles {
+		base := f
+		if i := strings.LastIndexByte(f, '/'); i >= 0 {
+			base = f[i+1:]
+		}
+		if base == "go.mod" || base == "go.sum" || strings.HasSuffix(f, ".go") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+// Package vuln scopes a govulncheck run to the symbols actually reachable
+// from the code a pull request changed, so XPRR reports GO-YYYY-NNNN
+// advisories that matter rather than every advisory touching a dependency
+// somewhere in the module graph.
+package vuln
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"golang.org/x/vuln/scan"
+)
+
+// Vuln is one advisory govulncheck reported as reachable from the scanned
+// packages.
+type Vuln struct {
+	ID        string
+	Package   string
+	Symbol    string
+	FixedIn   string
+	CallStack []string
+}
+
+// Scanner runs govulncheck against a module on disk.
+type Scanner struct {
+	// Dir is the module root to scan, e.g. the checkout of the PR branch.
+	Dir string
+}
+
+// NewScanner returns a Scanner rooted at dir.
+func NewScanner(dir string) *Scanner {
+	return &Scanner{Dir: dir}
+}
+
+// Scan runs govulncheck's reachability analysis over patterns (typically
+// "./..." restricted to the changed packages) and returns every advisory
+// it found to be reachable.
+func (s *Scanner) Scan(ctx context.Context, patterns ...string) ([]Vuln, error) {
+	args := append([]string{"-C", s.Dir, "-json"}, patterns...)
+	cmd := scan.Command(ctx, args...)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &bytes.Buffer{}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("vuln: starting govulncheck: %w", err)
+	}
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("vuln: running govulncheck: %w", err)
+	}
+	return parse(&stdout)
+}
+
+// govulnMessage mirrors the subset of govulncheck's JSON protocol
+// (golang.org/x/vuln/internal/govulncheck.Message) XPRR consumes: OSV
+// metadata and per-call-site findings.
+type govulnMessage struct {
+	OSV *struct {
+		ID       string `json:"id"`
+		Affected []struct {
+			Package struct {
+				Name string `json:"name"`
+			} `json:"package"`
+			Ranges []struct {
+				Events []struct {
+					Fixed string `json:"fixed"`
+				} `json:"events"`
+			} `json:"ranges"`
+		} `json:"affected"`
+	} `json:"osv,omitempty"`
+	Finding *struct {
+		OSV          string `json:"osv"`
+		FixedVersion string `json:"fixed_version"`
+		Trace        []struct {
+			Package  string `json:"package"`
+			Function string `json:"function"`
+		} `json:"trace"`
+	} `json:"finding,omitempty"`
+}
+
+func parse(r io.Reader) ([]Vuln, error) {
+	var vulns []Vuln
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var msg govulnMessage
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			return nil, fmt.Errorf("vuln: decoding govulncheck output: %w", err)
+		}
+		if msg.Finding == nil || len(msg.Finding.Trace) == 0 {
+			continue
+		}
+		top := msg.Finding.Trace[0]
+		callStack := make([]string, len(msg.Finding.Trace))
+		for i, frame := range msg.Finding.Trace {
+			callStack[i] = frame.Package + "." + frame.Function
+		}
+		vulns = append(vulns, Vuln{
+			ID:        msg.Finding.OSV,
+			Package:   top.Package,
+			Symbol:    top.Function,
+			FixedIn:   msg.Finding.FixedVersion,
+			CallStack: callStack,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vulns, nil
+}
@@ -0,0 +1,68 @@
+// Package autofix turns deterministic analyzer findings into GitHub
+// "suggested change" review comments, via a ```suggestion fenced block, so
+// a maintainer can apply the fix with "Commit suggestion" instead of
+// writing it by hand.
+package autofix
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+)
+
+// PatchHunk replaces the lines [StartLine, EndLine] of File with
+// Replacement, anchored to whichever rule produced it.
+type PatchHunk struct {
+	File        string
+	StartLine   int
+	EndLine     int
+	Replacement []string
+	Rule        string
+}
+
+// Fixer proposes a mechanical fix for findings from one rule. Fix returns
+// ok=false when it can't safely repair this particular finding (e.g. the
+// surrounding code doesn't match the shape it knows how to rewrite).
+type Fixer interface {
+	Rule() string
+	Fix(finding analysis.Finding, lines []string) (PatchHunk, bool)
+}
+
+// Registry holds one Fixer per rule name.
+type Registry struct {
+	fixers map[string]Fixer
+}
+
+// NewRegistry returns an empty Registry ready for Register calls.
+func NewRegistry() *Registry {
+	return &Registry{fixers: make(map[string]Fixer)}
+}
+
+// Register adds f, replacing any existing Fixer for the same rule.
+func (r *Registry) Register(f Fixer) {
+	r.fixers[f.Rule()] = f
+}
+
+// Fix looks up the Fixer registered for finding.Rule and asks it to repair
+// finding. It returns ok=false if no Fixer is registered for that rule.
+func (r *Registry) Fix(finding analysis.Finding, lines []string) (PatchHunk, bool) {
+	f, ok := r.fixers[finding.Rule]
+	if !ok {
+		return PatchHunk{}, false
+	}
+	return f.Fix(finding, lines)
+}
+
+// RenderSuggestion renders hunk as the body of a GitHub review comment
+// using a ```suggestion fenced block.
+func RenderSuggestion(hunk PatchHunk) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Auto-fix for `%s`:\n\n```suggestion\n", hunk.Rule)
+	for _, line := range hunk.Replacement {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	b.WriteString("```\n")
+	return b.String()
+}
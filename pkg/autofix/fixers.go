@@ -0,0 +1,314 @@
+package autofix
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+)
+
+// Default returns the Registry XPRR ships out of the box, covering the
+// low-risk rewrites safe enough to auto-suggest without a human in the
+// loop: wrapping an ignored destructive-call error, deleting an unused
+// local, extracting a magic number into a named constant, wrapping an
+// overlong line, and stubbing a missing doc comment.
+func Default() *Registry {
+	r := NewRegistry()
+	r.Register(UncheckedErrorFixer{})
+	r.Register(UnusedLocalFixer{})
+	r.Register(MagicNumberFixer{})
+	r.Register(LongLineFixer{})
+	r.Register(MissingDocCommentFixer{})
+	return r
+}
+
+// UncheckedErrorFixer repairs analysis/rules.UncheckedError findings by
+// assigning the ignored error to the blank identifier, e.g.
+// `os.Remove("temp.txt")` becomes `_ = os.Remove("temp.txt")`.
+type UncheckedErrorFixer struct{}
+
+func (UncheckedErrorFixer) Rule() string { return "unchecked-error" }
+
+func (UncheckedErrorFixer) Fix(finding analysis.Finding, lines []string) (PatchHunk, bool) {
+	if finding.Line < 1 || finding.Line > len(lines) {
+		return PatchHunk{}, false
+	}
+	line := lines[finding.Line-1]
+	trimmed := strings.TrimLeft(line, " \t")
+	if strings.HasPrefix(trimmed, "_ =") || strings.HasPrefix(trimmed, "if ") {
+		return PatchHunk{}, false
+	}
+	indent := line[:len(line)-len(trimmed)]
+	return PatchHunk{
+		File:        finding.File,
+		StartLine:   finding.Line,
+		EndLine:     finding.Line,
+		Replacement: []string{indent + "_ = " + trimmed},
+		Rule:        finding.Rule,
+	}, true
+}
+
+// UnusedLocalFixer repairs analysis/rules.UnusedLocal findings. When the
+// statement declares only the unused variable, the whole line is deleted;
+// when it shares a `:=`/`var` with other names (e.g. `a, b := f()`), only
+// the unused name is rewritten to `_`, since deleting the line would also
+// drop bindings the rest of the function still uses.
+type UnusedLocalFixer struct{}
+
+func (UnusedLocalFixer) Rule() string { return "unused-local" }
+
+// unusedLocalWrapper lets a single declaration statement be parsed on its
+// own, without a surrounding file, so Fix can work from real go/ast
+// identifier positions instead of guessing byte offsets in the source text.
+const unusedLocalWrapper = "package p; func _() { "
+
+func (UnusedLocalFixer) Fix(finding analysis.Finding, lines []string) (PatchHunk, bool) {
+	if finding.Line < 1 || finding.Line > len(lines) {
+		return PatchHunk{}, false
+	}
+	name := unusedLocalName(finding.Message)
+	if name == "" {
+		return PatchHunk{}, false
+	}
+	line := lines[finding.Line-1]
+	trimmed := strings.TrimLeft(line, " \t")
+	indent := line[:len(line)-len(trimmed)]
+
+	idents, fset, err := declIdents(trimmed)
+	if err != nil || len(idents) == 0 {
+		return PatchHunk{}, false
+	}
+	if len(idents) == 1 {
+		if idents[0].Name != name {
+			return PatchHunk{}, false
+		}
+		return PatchHunk{
+			File:        finding.File,
+			StartLine:   finding.Line,
+			EndLine:     finding.Line,
+			Replacement: nil, // empty replacement deletes the line
+			Rule:        finding.Rule,
+		}, true
+	}
+	for _, id := range idents {
+		if id.Name != name {
+			continue
+		}
+		offset := fset.Position(id.Pos()).Column - 1 - len(unusedLocalWrapper)
+		if offset < 0 || offset+len(name) > len(trimmed) || trimmed[offset:offset+len(name)] != name {
+			return PatchHunk{}, false
+		}
+		rewritten := trimmed[:offset] + "_" + trimmed[offset+len(name):]
+		return PatchHunk{
+			File:        finding.File,
+			StartLine:   finding.Line,
+			EndLine:     finding.Line,
+			Replacement: []string{indent + rewritten},
+			Rule:        finding.Rule,
+		}, true
+	}
+	return PatchHunk{}, false
+}
+
+// unusedLocalName extracts the variable name UnusedLocal reported from its
+// finding message, e.g. "local variable x is declared but never used" ->
+// "x".
+func unusedLocalName(message string) string {
+	const prefix = "local variable "
+	const suffix = " is declared but never used"
+	if !strings.HasPrefix(message, prefix) || !strings.HasSuffix(message, suffix) {
+		return ""
+	}
+	return message[len(prefix) : len(message)-len(suffix)]
+}
+
+// declIdents parses trimmed as a single declaration statement (a `:=` or
+// `var` statement) and returns the identifiers it declares, in source
+// order, along with the fset needed to translate their positions back into
+// byte offsets within trimmed.
+func declIdents(trimmed string) ([]*ast.Ident, *token.FileSet, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", unusedLocalWrapper+trimmed+" }", 0)
+	if err != nil || len(f.Decls) == 0 {
+		return nil, nil, fmt.Errorf("autofix: parsing declaration: %w", err)
+	}
+	fn, ok := f.Decls[0].(*ast.FuncDecl)
+	if !ok || fn.Body == nil || len(fn.Body.List) == 0 {
+		return nil, nil, fmt.Errorf("autofix: no statement in %q", trimmed)
+	}
+	switch stmt := fn.Body.List[0].(type) {
+	case *ast.AssignStmt:
+		if stmt.Tok != token.DEFINE {
+			return nil, nil, fmt.Errorf("autofix: %q is not a := statement", trimmed)
+		}
+		idents := make([]*ast.Ident, 0, len(stmt.Lhs))
+		for _, e := range stmt.Lhs {
+			if id, ok := e.(*ast.Ident); ok {
+				idents = append(idents, id)
+			}
+		}
+		return idents, fset, nil
+	case *ast.DeclStmt:
+		gd, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			return nil, nil, fmt.Errorf("autofix: %q is not a var declaration", trimmed)
+		}
+		var idents []*ast.Ident
+		for _, spec := range gd.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok {
+				idents = append(idents, vs.Names...)
+			}
+		}
+		return idents, fset, nil
+	default:
+		return nil, nil, fmt.Errorf("autofix: %q is not a declaration statement", trimmed)
+	}
+}
+
+// MagicNumberFixer repairs analysis/rules.MagicNumber findings for the
+// common `name := <literal>` shape, hoisting the literal into a sibling
+// named constant declared on the line above.
+type MagicNumberFixer struct{}
+
+func (MagicNumberFixer) Rule() string { return "magic-number" }
+
+func (MagicNumberFixer) Fix(finding analysis.Finding, lines []string) (PatchHunk, bool) {
+	if finding.Line < 1 || finding.Line > len(lines) {
+		return PatchHunk{}, false
+	}
+	line := lines[finding.Line-1]
+	trimmed := strings.TrimLeft(line, " \t")
+	indent := line[:len(line)-len(trimmed)]
+	name, literal, ok := strings.Cut(trimmed, ":=")
+	if !ok {
+		return PatchHunk{}, false
+	}
+	name = strings.TrimSpace(name)
+	literal = strings.TrimSpace(literal)
+	if _, err := strconv.Atoi(literal); err != nil || name == "" {
+		return PatchHunk{}, false
+	}
+	constName := "default" + strings.ToUpper(name[:1]) + name[1:]
+	return PatchHunk{
+		File:      finding.File,
+		StartLine: finding.Line,
+		EndLine:   finding.Line,
+		Replacement: []string{
+			fmt.Sprintf("%sconst %s = %s", indent, constName, literal),
+			fmt.Sprintf("%s%s := %s", indent, name, constName),
+		},
+		Rule: finding.Rule,
+	}, true
+}
+
+// LongLineFixer repairs analysis/rules.LongLine findings for the common
+// `name := "a long string literal"` shape by splitting the literal into
+// concatenated chunks that each fit under the limit.
+type LongLineFixer struct {
+	Limit int
+}
+
+func (LongLineFixer) Rule() string { return "long-line" }
+
+func (f LongLineFixer) Fix(finding analysis.Finding, lines []string) (PatchHunk, bool) {
+	limit := f.Limit
+	if limit == 0 {
+		limit = 120
+	}
+	if finding.Line < 1 || finding.Line > len(lines) {
+		return PatchHunk{}, false
+	}
+	line := lines[finding.Line-1]
+	trimmed := strings.TrimLeft(line, " \t")
+	indent := line[:len(line)-len(trimmed)]
+	name, rhs, ok := strings.Cut(trimmed, ":=")
+	if !ok {
+		return PatchHunk{}, false
+	}
+	rhs = strings.TrimSpace(rhs)
+	if len(rhs) < 2 || rhs[0] != '"' || rhs[len(rhs)-1] != '"' {
+		return PatchHunk{}, false
+	}
+	// Unquote first so chunking works on the literal's actual runes, not its
+	// source text: slicing the source directly could bisect an escape
+	// sequence (\n, \") or split a multibyte rune in half, then re-quoting
+	// the halves would corrupt the value instead of just reflowing it.
+	content, err := strconv.Unquote(rhs)
+	if err != nil {
+		return PatchHunk{}, false
+	}
+	chunkSize := limit - len(indent) - 4
+	if chunkSize < 8 {
+		return PatchHunk{}, false
+	}
+	runes := []rune(content)
+	var replacement []string
+	replacement = append(replacement, indent+strings.TrimSpace(name)+" := \"\"+")
+	for len(runes) > 0 {
+		n := chunkSize
+		if n > len(runes) {
+			n = len(runes)
+		}
+		sep := " +"
+		if n == len(runes) {
+			sep = ""
+		}
+		replacement = append(replacement, fmt.Sprintf("%s\t%s%s", indent, strconv.Quote(string(runes[:n])), sep))
+		runes = runes[n:]
+	}
+	return PatchHunk{
+		File:        finding.File,
+		StartLine:   finding.Line,
+		EndLine:     finding.Line,
+		Replacement: replacement,
+		Rule:        finding.Rule,
+	}, true
+}
+
+// MissingDocCommentFixer repairs analysis/rules.MissingDocComment findings
+// by inserting a stub doc comment above the declaration, naming the
+// convention a human still needs to fill in with the real rationale.
+type MissingDocCommentFixer struct{}
+
+func (MissingDocCommentFixer) Rule() string { return "missing-doc-comment" }
+
+func (MissingDocCommentFixer) Fix(finding analysis.Finding, lines []string) (PatchHunk, bool) {
+	if finding.Line < 1 || finding.Line > len(lines) {
+		return PatchHunk{}, false
+	}
+	line := lines[finding.Line-1]
+	trimmed := strings.TrimLeft(line, " \t")
+	indent := line[:len(line)-len(trimmed)]
+	name := declaredName(trimmed)
+	if name == "" {
+		return PatchHunk{}, false
+	}
+	return PatchHunk{
+		File:      finding.File,
+		StartLine: finding.Line,
+		EndLine:   finding.Line,
+		Replacement: []string{
+			indent + "// " + name + " TODO: document.",
+			line,
+		},
+		Rule: finding.Rule,
+	}, true
+}
+
+func declaredName(trimmed string) string {
+	for _, prefix := range []string{"func ", "type ", "package "} {
+		if !strings.HasPrefix(trimmed, prefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(trimmed, prefix)
+		fields := strings.FieldsFunc(rest, func(r rune) bool { return r == ' ' || r == '(' })
+		if len(fields) > 0 {
+			return fields[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,145 @@
+package autofix_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"github.com/inderanz/x-pull-request-reviewer/pkg/autofix"
+)
+
+func TestUnusedLocalFixer(t *testing.T) {
+	t.Run("single-name declaration is deleted", func(t *testing.T) {
+		lines := []string{
+			"func f() {",
+			"\tunused := 1",
+			"}",
+		}
+		finding := analysis.Finding{
+			Rule: "unused-local", Line: 2,
+			Message: "local variable unused is declared but never used",
+		}
+		hunk, ok := autofix.UnusedLocalFixer{}.Fix(finding, lines)
+		if !ok {
+			t.Fatal("expected a fix")
+		}
+		if hunk.Replacement != nil {
+			t.Errorf("expected the line to be deleted, got %v", hunk.Replacement)
+		}
+	})
+
+	t.Run("multi-name assignment keeps the call, blanks only the unused name", func(t *testing.T) {
+		lines := []string{
+			"func f() (int, error) {",
+			"\ta, b := one(), two()",
+			"\treturn b, nil",
+			"}",
+		}
+		finding := analysis.Finding{
+			Rule: "unused-local", Line: 2,
+			Message: "local variable a is declared but never used",
+		}
+		hunk, ok := autofix.UnusedLocalFixer{}.Fix(finding, lines)
+		if !ok {
+			t.Fatal("expected a fix")
+		}
+		if len(hunk.Replacement) != 1 {
+			t.Fatalf("expected one replacement line, got %v", hunk.Replacement)
+		}
+		got := hunk.Replacement[0]
+		want := "\t_, b := one(), two()"
+		if got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+}
+
+func TestLongLineFixer(t *testing.T) {
+	t.Run("splits a plain literal into chunks that reassemble to the same value", func(t *testing.T) {
+		content := strings.Repeat("ab", 100)
+		lines := []string{"\tlongVar := " + strconv.Quote(content)}
+		finding := analysis.Finding{Rule: "long-line", Line: 1}
+		hunk, ok := autofix.LongLineFixer{Limit: 40}.Fix(finding, lines)
+		if !ok {
+			t.Fatal("expected a fix")
+		}
+		if got := reassembleQuoted(t, hunk.Replacement); got != content {
+			t.Errorf("reassembled value = %q, want %q", got, content)
+		}
+	})
+
+	t.Run("does not corrupt an escape sequence at a chunk boundary", func(t *testing.T) {
+		// chunkSize will land mid-"\n" if the fixer ever slices the raw
+		// source text instead of the decoded value.
+		content := strings.Repeat("a", 10) + "\n" + strings.Repeat("b", 10)
+		lines := []string{"\tlongVar := " + strconv.Quote(content)}
+		finding := analysis.Finding{Rule: "long-line", Line: 1}
+		hunk, ok := autofix.LongLineFixer{Limit: 20}.Fix(finding, lines)
+		if !ok {
+			t.Fatal("expected a fix")
+		}
+		if got := reassembleQuoted(t, hunk.Replacement); got != content {
+			t.Errorf("reassembled value = %q, want %q", got, content)
+		}
+	})
+
+	t.Run("does not split a multibyte rune in half", func(t *testing.T) {
+		content := strings.Repeat("x", 10) + strings.Repeat("日本語", 10)
+		lines := []string{"\tlongVar := " + strconv.Quote(content)}
+		finding := analysis.Finding{Rule: "long-line", Line: 1}
+		hunk, ok := autofix.LongLineFixer{Limit: 20}.Fix(finding, lines)
+		if !ok {
+			t.Fatal("expected a fix")
+		}
+		if got := reassembleQuoted(t, hunk.Replacement); got != content {
+			t.Errorf("reassembled value = %q, want %q", got, content)
+		}
+	})
+}
+
+// reassembleQuoted unquotes and concatenates every `"..."` chunk in a
+// LongLineFixer replacement (skipping the leading `name := ""+` line),
+// mirroring what the Go compiler does with adjacent string-literal
+// concatenation.
+func reassembleQuoted(t *testing.T, replacement []string) string {
+	t.Helper()
+	var b strings.Builder
+	for _, line := range replacement[1:] {
+		trimmed := strings.TrimSpace(line)
+		trimmed = strings.TrimSuffix(trimmed, "+")
+		trimmed = strings.TrimSpace(trimmed)
+		chunk, err := strconv.Unquote(trimmed)
+		if err != nil {
+			t.Fatalf("unquoting replacement chunk %q: %v", trimmed, err)
+		}
+		b.WriteString(chunk)
+	}
+	return b.String()
+}
+
+func TestMagicNumberFixer(t *testing.T) {
+	lines := []string{"\ttimeout := 30"}
+	finding := analysis.Finding{Rule: "magic-number", Line: 1}
+	hunk, ok := autofix.MagicNumberFixer{}.Fix(finding, lines)
+	if !ok {
+		t.Fatal("expected a fix")
+	}
+	want := []string{"\tconst defaultTimeout = 30", "\ttimeout := defaultTimeout"}
+	if len(hunk.Replacement) != len(want) || hunk.Replacement[0] != want[0] || hunk.Replacement[1] != want[1] {
+		t.Errorf("got %v, want %v", hunk.Replacement, want)
+	}
+}
+
+func TestUncheckedErrorFixer(t *testing.T) {
+	lines := []string{`	os.Remove("temp.txt")`}
+	finding := analysis.Finding{Rule: "unchecked-error", Line: 1}
+	hunk, ok := autofix.UncheckedErrorFixer{}.Fix(finding, lines)
+	if !ok {
+		t.Fatal("expected a fix")
+	}
+	want := "\t_ = os.Remove(\"temp.txt\")"
+	if len(hunk.Replacement) != 1 || hunk.Replacement[0] != want {
+		t.Errorf("got %v, want [%q]", hunk.Replacement, want)
+	}
+}
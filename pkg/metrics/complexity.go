@@ -0,0 +1,127 @@
+package metrics
+
+import "go/ast"
+
+// cyclomaticComplexity counts decision points the same way gocyclo does:
+// one point per branch (if/for/case/comm-clause) plus one per &&/||, with a
+// complexity of 1 for a function with no branches at all.
+func cyclomaticComplexity(body *ast.BlockStmt) int {
+	complexity := 1
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt, *ast.CaseClause, *ast.CommClause:
+			complexity++
+		case *ast.BinaryExpr:
+			if v.Op.String() == "&&" || v.Op.String() == "||" {
+				complexity++
+			}
+		}
+		return true
+	})
+	return complexity
+}
+
+// cognitiveComplexity approximates gocognit: like cyclomaticComplexity, but
+// each branch costs more the deeper it's nested, because deeply nested
+// control flow is harder for a reviewer to hold in their head than the
+// same number of branches laid out flat.
+func cognitiveComplexity(body *ast.BlockStmt, nesting int) int {
+	var walkStmt func(stmt ast.Stmt, depth int) int
+	var walkStmts func(stmts []ast.Stmt, depth int) int
+
+	walkStmts = func(stmts []ast.Stmt, depth int) int {
+		total := 0
+		for _, s := range stmts {
+			total += walkStmt(s, depth)
+		}
+		return total
+	}
+
+	walkStmt = func(stmt ast.Stmt, depth int) int {
+		switch v := stmt.(type) {
+		case *ast.IfStmt:
+			total := 1 + depth + countBoolOps(v.Cond)
+			total += walkStmt(v.Body, depth+1)
+			if v.Else != nil {
+				total++ // "else"/"else if" each add a flat point, not a nesting one
+				total += walkStmt(v.Else, depth)
+			}
+			return total
+		case *ast.ForStmt:
+			return 1 + depth + walkStmt(v.Body, depth+1)
+		case *ast.RangeStmt:
+			return 1 + depth + walkStmt(v.Body, depth+1)
+		case *ast.SwitchStmt:
+			total := 1 + depth
+			for _, clause := range v.Body.List {
+				cc := clause.(*ast.CaseClause)
+				total += walkStmts(cc.Body, depth+1)
+			}
+			return total
+		case *ast.TypeSwitchStmt:
+			total := 1 + depth
+			for _, clause := range v.Body.List {
+				cc := clause.(*ast.CaseClause)
+				total += walkStmts(cc.Body, depth+1)
+			}
+			return total
+		case *ast.SelectStmt:
+			total := 1 + depth
+			for _, clause := range v.Body.List {
+				comm := clause.(*ast.CommClause)
+				total += walkStmts(comm.Body, depth+1)
+			}
+			return total
+		case *ast.BlockStmt:
+			return walkStmts(v.List, depth)
+		default:
+			return 0
+		}
+	}
+
+	return walkStmt(body, nesting)
+}
+
+// countBoolOps adds one per &&/|| in a condition, matching how an
+// if-statement with multiple boolean operators is harder to read than one
+// with a single comparison.
+func countBoolOps(expr ast.Expr) int {
+	count := 0
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if be, ok := n.(*ast.BinaryExpr); ok && (be.Op.String() == "&&" || be.Op.String() == "||") {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// exportedCalls collects, in call order with duplicates removed, every
+// exported identifier body invokes via a selector expression (pkg.Func or
+// recv.Method) - a crude but cheap "what does this function touch" summary
+// for the review comment's mini call graph.
+func exportedCalls(body *ast.BlockStmt) []string {
+	seen := make(map[string]bool)
+	var calls []string
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !ast.IsExported(sel.Sel.Name) {
+			return true
+		}
+		ident, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		name := ident.Name + "." + sel.Sel.Name
+		if !seen[name] {
+			seen[name] = true
+			calls = append(calls, name)
+		}
+		return true
+	})
+	return calls
+}
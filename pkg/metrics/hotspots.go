@@ -0,0 +1,15 @@
+package metrics
+
+import "sort"
+
+// TopN returns the n highest-scoring functions from all, highest first. It
+// does not mutate all.
+func TopN(all []FunctionMetrics, n int) []FunctionMetrics {
+	sorted := make([]FunctionMetrics, len(all))
+	copy(sorted, all)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Score > sorted[j].Score })
+	if n > len(sorted) {
+		n = len(sorted)
+	}
+	return sorted[:n]
+}
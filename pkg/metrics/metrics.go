@@ -0,0 +1,76 @@
+// Package metrics computes per-function complexity signals - cyclomatic
+// complexity, cognitive complexity, length, and parameter count - for
+// changed files, so XPRR can rank which functions in a PR most need a
+// human reviewer's attention rather than relying on the LLM's attention
+// span alone. The approach mirrors funlen/gocyclo/gocognit.
+package metrics
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// FunctionMetrics summarizes one function or method declaration.
+type FunctionMetrics struct {
+	Name       string
+	File       string
+	Line       int
+	Cyclomatic int
+	Cognitive  int
+	Lines      int
+	Params     int
+	Calls      []string // exported identifiers this function touches, for a mini call graph
+	Score      float64
+}
+
+// Analyze walks every function declaration in pkg and returns its metrics,
+// in declaration order.
+func Analyze(pkg *packages.Package) ([]FunctionMetrics, error) {
+	var out []FunctionMetrics
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			pos := pkg.Fset.Position(fn.Pos())
+			end := pkg.Fset.Position(fn.End())
+			m := FunctionMetrics{
+				Name:       fn.Name.Name,
+				File:       pos.Filename,
+				Line:       pos.Line,
+				Lines:      end.Line - pos.Line + 1,
+				Params:     countParams(fn),
+				Cyclomatic: cyclomaticComplexity(fn.Body),
+				Cognitive:  cognitiveComplexity(fn.Body, 0),
+				Calls:      exportedCalls(fn.Body),
+			}
+			m.Score = score(m)
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func countParams(fn *ast.FuncDecl) int {
+	if fn.Type.Params == nil {
+		return 0
+	}
+	n := 0
+	for _, field := range fn.Type.Params.List {
+		if len(field.Names) == 0 {
+			n++ // unnamed parameter, e.g. an interface method's embedded type
+			continue
+		}
+		n += len(field.Names)
+	}
+	return n
+}
+
+// score weights cognitive complexity highest since it best approximates
+// how hard the function is for a reviewer to hold in their head, with
+// cyclomatic complexity, length, and parameter count as secondary signals.
+func score(m FunctionMetrics) float64 {
+	return float64(m.Cognitive)*3 + float64(m.Cyclomatic)*2 + float64(m.Lines)/10 + float64(m.Params)
+}
@@ -0,0 +1,38 @@
+// Package review renders XPRR's findings - deterministic analyzer output
+// alongside the LLM's own commentary - into the markdown XPRR posts back to
+// a pull request.
+package review
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+)
+
+// RenderAnalysisSection formats findings as a markdown table, grouped by
+// file and ordered by line, for inclusion above the LLM's prose commentary.
+// It returns "" when there is nothing to report.
+func RenderAnalysisSection(findings []analysis.Finding) string {
+	if len(findings) == 0 {
+		return ""
+	}
+	sorted := make([]analysis.Finding, len(findings))
+	copy(sorted, findings)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].File != sorted[j].File {
+			return sorted[i].File < sorted[j].File
+		}
+		return sorted[i].Line < sorted[j].Line
+	})
+
+	var b strings.Builder
+	b.WriteString("### Static analysis findings\n\n")
+	b.WriteString("| Severity | File:Line | Rule | Message |\n")
+	b.WriteString("|---|---|---|---|\n")
+	for _, f := range sorted {
+		fmt.Fprintf(&b, "| %s | `%s:%d` | `%s` | %s |\n", f.Severity, f.File, f.Line, f.Rule, f.Message)
+	}
+	return b.String()
+}
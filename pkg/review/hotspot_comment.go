@@ -0,0 +1,33 @@
+package review
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inderanz/x-pull-request-reviewer/pkg/metrics"
+)
+
+// TopHotspots is how many risky functions RenderHotspotSection surfaces -
+// enough to direct reviewer attention without turning into a second wall
+// of text alongside the LLM commentary.
+const TopHotspots = 5
+
+// RenderHotspotSection formats the riskiest changed functions, ranked by
+// metrics.FunctionMetrics.Score, with a mini call graph of the exported
+// identifiers each one touches.
+func RenderHotspotSection(all []metrics.FunctionMetrics) string {
+	top := metrics.TopN(all, TopHotspots)
+	if len(top) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "### Top %d risky functions changed in this PR\n\n", len(top))
+	for _, m := range top {
+		fmt.Fprintf(&b, "- `%s` (%s:%d) — cyclomatic %d, cognitive %d, %d lines, %d params\n",
+			m.Name, m.File, m.Line, m.Cyclomatic, m.Cognitive, m.Lines, m.Params)
+		if len(m.Calls) > 0 {
+			fmt.Fprintf(&b, "  - touches: %s\n", strings.Join(m.Calls, ", "))
+		}
+	}
+	return b.String()
+}
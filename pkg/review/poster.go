@@ -0,0 +1,106 @@
+package review
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/llmreview"
+)
+
+// Poster publishes review comments to a pull request. The GitHub
+// implementation posts each llmreview.Comment as its own line-anchored
+// comment via the Reviews API, instead of collapsing everything into one
+// issue comment.
+type Poster interface {
+	Post(ctx context.Context, prNumber int, comments []llmreview.Comment) error
+}
+
+// GitHubPoster posts comments to a pull request via GitHub's Reviews API
+// (POST /repos/{owner}/{repo}/pulls/{number}/reviews), which is the
+// endpoint that anchors each comment to its own line rather than folding
+// everything into a single issue comment.
+type GitHubPoster struct {
+	// Owner and Repo identify the repository, e.g. "inderanz" and
+	// "x-pull-request-reviewer".
+	Owner, Repo string
+	// Token is a GitHub token with pull-request write access.
+	Token string
+	// BaseURL overrides the API root, e.g. for a GitHub Enterprise Server
+	// instance. Defaults to https://api.github.com.
+	BaseURL string
+	// HTTPClient overrides the client used to send the request. Defaults
+	// to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// reviewRequest is the body POST .../reviews expects.
+type reviewRequest struct {
+	Event    string          `json:"event"`
+	Comments []reviewComment `json:"comments"`
+}
+
+// reviewComment is one entry in reviewRequest.Comments.
+type reviewComment struct {
+	Path      string `json:"path"`
+	Line      int    `json:"line"`
+	StartLine int    `json:"start_line,omitempty"`
+	Body      string `json:"body"`
+}
+
+// Post submits comments as a single review (event "COMMENT") against pull
+// request prNumber. It does nothing if comments is empty.
+func (p *GitHubPoster) Post(ctx context.Context, prNumber int, comments []llmreview.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	reqBody := reviewRequest{Event: "COMMENT"}
+	for _, c := range comments {
+		rc := reviewComment{Path: c.File, Line: c.Line, Body: c.Body}
+		if c.StartLine != 0 && c.StartLine != c.Line {
+			rc.StartLine = c.StartLine
+		}
+		reqBody.Comments = append(reqBody.Comments, rc)
+	}
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return fmt.Errorf("review: encoding review payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/reviews", p.baseURL(), p.Owner, p.Repo, prNumber)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("review: building request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/vnd.github+json")
+	httpReq.Header.Set("Authorization", "Bearer "+p.Token)
+
+	resp, err := p.client().Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("review: posting review: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("review: GitHub returned %s: %s", resp.Status, body)
+	}
+	return nil
+}
+
+func (p *GitHubPoster) baseURL() string {
+	if p.BaseURL != "" {
+		return p.BaseURL
+	}
+	return "https://api.github.com"
+}
+
+func (p *GitHubPoster) client() *http.Client {
+	if p.HTTPClient != nil {
+		return p.HTTPClient
+	}
+	return http.DefaultClient
+}
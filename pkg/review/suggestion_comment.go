@@ -0,0 +1,31 @@
+package review
+
+import "github.com/inderanz/x-pull-request-reviewer/pkg/autofix"
+
+// SuggestionComment is a GitHub pull-request review comment anchored to a
+// line range on the diff, carrying a ```suggestion body a maintainer can
+// commit with one click.
+type SuggestionComment struct {
+	Path      string
+	Line      int
+	StartLine int // omitted by the GitHub API when equal to Line (single-line suggestion)
+	Body      string
+}
+
+// SuggestionComments converts autofix hunks into the review comments the
+// GitHub Reviews API expects, keyed to each hunk's start_line/line.
+func SuggestionComments(hunks []autofix.PatchHunk) []SuggestionComment {
+	comments := make([]SuggestionComment, 0, len(hunks))
+	for _, h := range hunks {
+		c := SuggestionComment{
+			Path: h.File,
+			Line: h.EndLine,
+			Body: autofix.RenderSuggestion(h),
+		}
+		if h.StartLine != h.EndLine {
+			c.StartLine = h.StartLine
+		}
+		comments = append(comments, c)
+	}
+	return comments
+}
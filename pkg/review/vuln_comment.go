@@ -0,0 +1,71 @@
+package review
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/semver"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/vuln"
+)
+
+// RenderVulnSection formats reachable govulncheck findings, grouped by
+// module, with the minimum version bump that clears every advisory in that
+// group.
+func RenderVulnSection(vulns []vuln.Vuln) string {
+	if len(vulns) == 0 {
+		return ""
+	}
+	byPackage := make(map[string][]vuln.Vuln)
+	for _, v := range vulns {
+		byPackage[v.Package] = append(byPackage[v.Package], v)
+	}
+	pkgs := make([]string, 0, len(byPackage))
+	for pkg := range byPackage {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	var b strings.Builder
+	b.WriteString("### Reachable vulnerabilities\n\n")
+	for _, pkg := range pkgs {
+		group := byPackage[pkg]
+		fmt.Fprintf(&b, "**%s** — upgrade to `%s` to clear:\n\n", pkg, minFixedVersion(group))
+		for _, v := range group {
+			fmt.Fprintf(&b, "- [%s](https://pkg.go.dev/vuln/%s): reachable via `%s`\n", v.ID, v.ID, strings.Join(v.CallStack, " -> "))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// minFixedVersion returns the highest FixedIn across group, since a single
+// upgrade must clear every advisory reported for the package. Versions are
+// compared with semver.Compare rather than lexically: "1.10.0" sorts
+// before "1.9.0" as plain strings, which would recommend an upgrade that
+// leaves the 1.10.0 advisory unaddressed.
+func minFixedVersion(group []vuln.Vuln) string {
+	best := ""
+	for _, v := range group {
+		if v.FixedIn == "" {
+			continue
+		}
+		if best == "" || semver.Compare(canonicalVersion(v.FixedIn), canonicalVersion(best)) > 0 {
+			best = v.FixedIn
+		}
+	}
+	if best == "" {
+		return "latest"
+	}
+	return best
+}
+
+// canonicalVersion prefixes v with "v" when missing: semver.Compare
+// requires the "v" prefix, but govulncheck's fixed_version field omits it.
+func canonicalVersion(v string) string {
+	if strings.HasPrefix(v, "v") {
+		return v
+	}
+	return "v" + v
+}
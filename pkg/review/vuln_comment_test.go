@@ -0,0 +1,38 @@
+package review
+
+import (
+	"testing"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/vuln"
+)
+
+func TestMinFixedVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		group []vuln.Vuln
+		want  string
+	}{
+		{
+			name:  "higher minor version wins over a lexically larger patch release",
+			group: []vuln.Vuln{{FixedIn: "1.9.0"}, {FixedIn: "1.10.0"}},
+			want:  "1.10.0",
+		},
+		{
+			name:  "single entry",
+			group: []vuln.Vuln{{FixedIn: "0.2.1"}},
+			want:  "0.2.1",
+		},
+		{
+			name:  "no FixedIn known falls back to latest",
+			group: []vuln.Vuln{{FixedIn: ""}},
+			want:  "latest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := minFixedVersion(tt.group); got != tt.want {
+				t.Errorf("minFixedVersion(%v) = %q, want %q", tt.group, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package sarif renders XPRR's security findings as SARIF 2.1.0 so GitHub's
+// code-scanning UI can ingest them directly via `--sarif-out`.
+package sarif
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"github.com/inderanz/x-pull-request-reviewer/internal/security"
+)
+
+const schemaURI = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+
+// Log is the top-level SARIF log object.
+type Log struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+// Run is a single analysis run, identifying the tool and its results.
+type Run struct {
+	Tool    Tool     `json:"tool"`
+	Results []Result `json:"results"`
+}
+
+// Tool describes XPRR itself and the rules it's capable of reporting.
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+// Driver names the tool and lists every rule it knows about.
+type Driver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+	InformationURI string `json:"informationUri,omitempty"`
+	Rules          []Rule `json:"rules"`
+}
+
+// Rule is a SARIF reportingDescriptor, tagged with the CWE it maps to.
+type Rule struct {
+	ID               string           `json:"id"`
+	Name             string           `json:"name"`
+	ShortDescription DescriptionBlock `json:"shortDescription"`
+	Properties       RuleProperties   `json:"properties,omitempty"`
+}
+
+// DescriptionBlock is SARIF's {"text": "..."} wrapper.
+type DescriptionBlock struct {
+	Text string `json:"text"`
+}
+
+// RuleProperties carries the CWE tag GitHub's code-scanning UI renders as a
+// badge next to a rule.
+type RuleProperties struct {
+	Tags []string `json:"tags,omitempty"`
+	CWE  string   `json:"cwe,omitempty"`
+}
+
+// Result is one finding, located precisely enough for GitHub to annotate
+// the exact line in the code-scanning tab.
+type Result struct {
+	RuleID    string           `json:"ruleId"`
+	Level     string           `json:"level"`
+	Message   DescriptionBlock `json:"message"`
+	Locations []Location       `json:"locations"`
+}
+
+// Location wraps the PhysicalLocation SARIF requires per result.
+type Location struct {
+	PhysicalLocation PhysicalLocation `json:"physicalLocation"`
+}
+
+// PhysicalLocation points at a file and the region within it.
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           Region           `json:"region"`
+}
+
+// ArtifactLocation names the file a Result belongs to, relative to the repo
+// root.
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// Region is the line/column span a Result annotates.
+type Region struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// FromFindings builds a one-run SARIF Log for findings, reported under tool
+// at the given version.
+func FromFindings(findings []security.Finding, tool, version string) *Log {
+	seen := make(map[string]bool)
+	var rules []Rule
+	var results []Result
+	for _, f := range findings {
+		if !seen[f.Rule] {
+			seen[f.Rule] = true
+			rules = append(rules, Rule{
+				ID:               f.Rule,
+				Name:             f.Rule,
+				ShortDescription: DescriptionBlock{Text: f.Message},
+				Properties:       RuleProperties{CWE: f.CWE, Tags: tags(f)},
+			})
+		}
+		results = append(results, Result{
+			RuleID:  f.Rule,
+			Level:   levelFor(f.Severity),
+			Message: DescriptionBlock{Text: f.Message},
+			Locations: []Location{{PhysicalLocation: PhysicalLocation{
+				ArtifactLocation: ArtifactLocation{URI: f.File},
+				Region:           Region{StartLine: f.Line, StartColumn: f.Column},
+			}}},
+		})
+	}
+	return &Log{
+		Schema:  schemaURI,
+		Version: "2.1.0",
+		Runs: []Run{{
+			Tool:    Tool{Driver: Driver{Name: tool, Version: version, Rules: rules}},
+			Results: results,
+		}},
+	}
+}
+
+// Encode writes l as indented JSON, the form GitHub's upload-sarif action
+// expects.
+func (l *Log) Encode(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(l)
+}
+
+func tags(f security.Finding) []string {
+	var t []string
+	if f.CWE != "" {
+		t = append(t, f.CWE)
+	}
+	return t
+}
+
+func levelFor(sev analysis.Severity) string {
+	switch sev {
+	case analysis.SeverityError:
+		return "error"
+	case analysis.SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
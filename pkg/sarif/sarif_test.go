@@ -0,0 +1,87 @@
+package sarif_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/inderanz/x-pull-request-reviewer/internal/analysis"
+	"github.com/inderanz/x-pull-request-reviewer/internal/security"
+	"github.com/inderanz/x-pull-request-reviewer/pkg/sarif"
+)
+
+func TestFromFindingsEncode(t *testing.T) {
+	findings := []security.Finding{
+		{
+			Finding: analysis.Finding{
+				Rule:     "hardcoded-credential",
+				File:     "main.go",
+				Line:     12,
+				Column:   3,
+				Severity: analysis.SeverityError,
+				Message:  "hardcoded credential",
+			},
+			CWE: "CWE-798",
+		},
+		{
+			Finding: analysis.Finding{
+				Rule:     "unchecked-error",
+				File:     "main.go",
+				Line:     20,
+				Column:   1,
+				Severity: analysis.SeverityWarning,
+				Message:  "error result is not checked",
+			},
+			CWE: "CWE-252",
+		},
+	}
+
+	log := sarif.FromFindings(findings, "xprr", "1.2.3")
+	if len(log.Runs) != 1 {
+		t.Fatalf("expected exactly one run, got %d", len(log.Runs))
+	}
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 {
+		t.Fatalf("expected one rule per distinct finding.Rule, got %d", len(run.Tool.Driver.Rules))
+	}
+	if len(run.Results) != 2 {
+		t.Fatalf("expected one result per finding, got %d", len(run.Results))
+	}
+	for i, f := range findings {
+		result := run.Results[i]
+		if result.RuleID != f.Rule {
+			t.Errorf("result %d: ruleId = %q, want %q", i, result.RuleID, f.Rule)
+		}
+		loc := result.Locations[0].PhysicalLocation
+		if loc.ArtifactLocation.URI != f.File || loc.Region.StartLine != f.Line {
+			t.Errorf("result %d: location = %+v, want file %q line %d", i, loc, f.File, f.Line)
+		}
+	}
+	for _, r := range run.Tool.Driver.Rules {
+		if r.Properties.CWE == "" {
+			t.Errorf("rule %q: expected a non-empty CWE property", r.ID)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := log.Encode(&buf); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	var roundTripped sarif.Log
+	if err := json.Unmarshal(buf.Bytes(), &roundTripped); err != nil {
+		t.Fatalf("decoding Encode output: %v", err)
+	}
+	if roundTripped.Version != "2.1.0" {
+		t.Errorf("version = %q, want 2.1.0", roundTripped.Version)
+	}
+}
+
+func TestFromFindingsEmpty(t *testing.T) {
+	log := sarif.FromFindings(nil, "xprr", "dev")
+	if len(log.Runs) != 1 {
+		t.Fatalf("expected exactly one run even with no findings, got %d", len(log.Runs))
+	}
+	if len(log.Runs[0].Results) != 0 || len(log.Runs[0].Tool.Driver.Rules) != 0 {
+		t.Errorf("expected no results or rules, got %+v", log.Runs[0])
+	}
+}